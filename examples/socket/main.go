@@ -7,6 +7,7 @@ import (
 	"log"
 	"net/http"
 
+	"github.com/wooobo/go-upbit-client/pkg/market"
 	wsClient "github.com/wooobo/go-upbit-client/pkg/socket"
 )
 
@@ -33,7 +34,7 @@ func serveWs(w http.ResponseWriter, r *http.Request) {
 	typeField := wsClient.TypeField{
 		Ticket: uuid.New().String(),
 		Type:   wsClient.TypeTicker,
-		Codes:  []string{"KRW-BTC", "KRW-ETH"},
+		Codes:  []market.Code{"KRW-BTC", "KRW-ETH"},
 	}
 	err = ws.Subscribe(typeField, "DEFAULT")
 	if err != nil {
@@ -56,7 +57,7 @@ func serveWs(w http.ResponseWriter, r *http.Request) {
 
 		err = connection.WriteJSON(data)
 
-		log.Printf("Ticker: %s, TradePrice: %f\n", data.Code, data.TradePrice)
+		log.Printf("Ticker: %s, TradePrice: %s\n", data.Code, data.TradePrice)
 	}
 }
 
@@ -0,0 +1,68 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Call 인터셉터에 전달되는 요청 한 건의 정보
+// 재시도되는 요청은 시도마다 새로운 Call 이 만들어진다.
+type Call struct {
+	Method     string        // 요청 메서드
+	Path       string        // 버전 이후 경로 (ex. /orders)
+	Query      url.Values    // 쿼리 파라미터
+	Form       url.Values    // form 본문 파라미터
+	Attempt    int           // 시도 횟수 (1부터 시작)
+	Request    *http.Request // 보낼 요청, BeforeSend 에서 헤더를 추가할 수 있다.
+	StatusCode int           // 응답 상태 코드, 응답을 받지 못했으면 0
+	Header     http.Header   // 응답 헤더
+	Duration   time.Duration // 요청을 보낸 뒤 응답을 받을 때까지 걸린 시간
+	Err        error         // 실패한 경우의 에러, API 에러 응답은 *apierror.Error
+}
+
+// Interceptor 모든 요청 전후에 실행되는 훅. 필요한 필드만 지정하면 된다.
+type Interceptor struct {
+	// BeforeSend 요청을 보내기 직전에 호출된다. 에러를 반환하면 요청을 보내지 않고 재시도 없이 그 에러로 실패한다.
+	BeforeSend func(ctx context.Context, call *Call) error
+	// AfterReceive 응답을 받은 뒤 상태 코드와 관계없이 호출된다. 에러 응답이면 call.Err 에 디코딩된 에러가 있다.
+	AfterReceive func(ctx context.Context, call *Call)
+	// OnError 요청이 어떤 이유로든 실패하면 호출된다.
+	OnError func(ctx context.Context, call *Call)
+}
+
+// WithInterceptors 인터셉터를 추가한다. 추가한 순서대로 실행된다.
+func WithInterceptors(interceptors ...Interceptor) Option {
+	return func(t *Transport) {
+		t.interceptors = append(t.interceptors, interceptors...)
+	}
+}
+
+func (t *Transport) beforeSend(ctx context.Context, call *Call) error {
+	for _, i := range t.interceptors {
+		if i.BeforeSend == nil {
+			continue
+		}
+		if err := i.BeforeSend(ctx, call); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *Transport) afterReceive(ctx context.Context, call *Call) {
+	for _, i := range t.interceptors {
+		if i.AfterReceive != nil {
+			i.AfterReceive(ctx, call)
+		}
+	}
+}
+
+func (t *Transport) onError(ctx context.Context, call *Call) {
+	for _, i := range t.interceptors {
+		if i.OnError != nil {
+			i.OnError(ctx, call)
+		}
+	}
+}
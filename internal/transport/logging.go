@@ -0,0 +1,64 @@
+package transport
+
+import (
+	"context"
+	"log/slog"
+	"maps"
+	"net/url"
+)
+
+const redacted = "[REDACTED]"
+
+// sensitiveKeys 로그에서 값을 가리는 파라미터 (출금 주소, 보조 출금 주소)
+var sensitiveKeys = []string{"address", "secondary_address"}
+
+// WithLogger 요청마다 메서드, 경로, 쿼리, form 본문, 상태 코드, 소요 시간을 기록한다.
+// 요청/응답 헤더와 JSON 본문은 기록하지 않으므로 Authorization 헤더의 JWT 는 남지 않는다.
+// 출금 주소와 보조 출금 주소는 [REDACTED] 로 가린다.
+func WithLogger(logger *slog.Logger) Option {
+	return func(t *Transport) {
+		t.logger = logger
+	}
+}
+
+func loggingInterceptor(logger *slog.Logger) Interceptor {
+	return Interceptor{
+		AfterReceive: func(ctx context.Context, call *Call) {
+			if call.Err != nil {
+				return
+			}
+			logger.LogAttrs(ctx, slog.LevelDebug, "upbit request",
+				slog.String("method", call.Method),
+				slog.String("path", call.Path),
+				slog.String("query", redact(call.Query)),
+				slog.String("form", redact(call.Form)),
+				slog.Int("attempt", call.Attempt),
+				slog.Int("status", call.StatusCode),
+				slog.Duration("latency", call.Duration),
+			)
+		},
+		OnError: func(ctx context.Context, call *Call) {
+			logger.LogAttrs(ctx, slog.LevelWarn, "upbit request failed",
+				slog.String("method", call.Method),
+				slog.String("path", call.Path),
+				slog.String("query", redact(call.Query)),
+				slog.String("form", redact(call.Form)),
+				slog.Int("attempt", call.Attempt),
+				slog.Int("status", call.StatusCode),
+				slog.Duration("latency", call.Duration),
+				slog.Any("error", call.Err),
+			)
+		},
+	}
+}
+
+// redact 민감한 파라미터의 값을 가린 뒤 인코딩한다. 원래 값은 바꾸지 않는다.
+func redact(values url.Values) string {
+	masked := maps.Clone(values)
+	for _, key := range sensitiveKeys {
+		if masked.Has(key) {
+			masked.Set(key, redacted)
+		}
+	}
+	return masked.Encode()
+}
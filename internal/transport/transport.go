@@ -0,0 +1,280 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/wooobo/go-upbit-client/pkg/apierror"
+	"github.com/wooobo/go-upbit-client/pkg/ratelimit"
+	"github.com/wooobo/go-upbit-client/pkg/retry"
+)
+
+const defaultTimeout = 10 * time.Second
+
+// Authorizer 요청에 인증 헤더를 추가한다. payload 는 요청 본문 또는 쿼리 파라미터이다.
+type Authorizer func(req *http.Request, payload url.Values)
+
+// Middleware http.RoundTripper 를 감싸는 미들웨어
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+// Transport public, private REST 클라이언트가 공유하는 요청 처리 계층
+type Transport struct {
+	baseURL      string
+	httpClient   *http.Client
+	timeout      time.Duration
+	timeoutSet   bool
+	userAgent    string
+	middlewares  []Middleware
+	limiter      *ratelimit.Limiter
+	remaining    *ratelimit.Tracker
+	retry        retry.Policy
+	authorize    Authorizer
+	interceptors []Interceptor
+	logger       *slog.Logger
+}
+
+type Option func(*Transport)
+
+// WithHTTPClient 요청에 사용할 *http.Client 를 지정한다.
+func WithHTTPClient(client *http.Client) Option {
+	return func(t *Transport) {
+		if client != nil {
+			t.httpClient = client
+		}
+	}
+}
+
+// WithBaseURL 버전을 포함한 API 기본 URL 을 지정한다. (ex. https://api.upbit.com/v1)
+func WithBaseURL(baseURL string) Option {
+	return func(t *Transport) {
+		t.baseURL = strings.TrimSuffix(baseURL, "/")
+	}
+}
+
+// WithUserAgent User-Agent 헤더를 지정한다.
+func WithUserAgent(userAgent string) Option {
+	return func(t *Transport) {
+		t.userAgent = userAgent
+	}
+}
+
+// WithTimeout 요청 타임아웃을 지정한다. (default: 10s)
+// WithHTTPClient 로 넘긴 클라이언트의 Timeout 보다 우선한다.
+func WithTimeout(timeout time.Duration) Option {
+	return func(t *Transport) {
+		t.timeout = timeout
+		t.timeoutSet = true
+	}
+}
+
+// WithRoundTripper http.RoundTripper 미들웨어를 추가한다. 먼저 추가한 미들웨어가 바깥쪽에서 실행된다.
+func WithRoundTripper(middlewares ...Middleware) Option {
+	return func(t *Transport) {
+		t.middlewares = append(t.middlewares, middlewares...)
+	}
+}
+
+// WithLimiter 클라이언트 측 요청 수 제한기를 지정한다.
+func WithLimiter(limiter *ratelimit.Limiter) Option {
+	return func(t *Transport) {
+		t.limiter = limiter
+	}
+}
+
+// WithRetry GET 요청 재시도 정책을 지정한다.
+func WithRetry(policy retry.Policy) Option {
+	return func(t *Transport) {
+		t.retry = policy
+	}
+}
+
+// WithAuthorizer 요청마다 인증 헤더를 추가한다.
+func WithAuthorizer(authorize Authorizer) Option {
+	return func(t *Transport) {
+		t.authorize = authorize
+	}
+}
+
+func New(baseURL string, opts ...Option) *Transport {
+	t := &Transport{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: &http.Client{},
+		remaining:  ratelimit.NewTracker(),
+		retry:      retry.DefaultPolicy,
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	if t.logger != nil {
+		t.interceptors = append(t.interceptors, loggingInterceptor(t.logger))
+	}
+
+	// 사용자가 넘긴 *http.Client 를 변경하지 않도록 복사해서 사용한다.
+	client := *t.httpClient
+	switch {
+	case t.timeoutSet:
+		client.Timeout = t.timeout
+	case client.Timeout == 0:
+		client.Timeout = defaultTimeout
+	}
+	if len(t.middlewares) > 0 {
+		rt := client.Transport
+		if rt == nil {
+			rt = http.DefaultTransport
+		}
+		for i := len(t.middlewares) - 1; i >= 0; i-- {
+			rt = t.middlewares[i](rt)
+		}
+		client.Transport = rt
+	}
+	t.httpClient = &client
+
+	return t
+}
+
+// Request 업비트 API 요청
+type Request struct {
+	Method string
+	Path   string      // 버전 이후 경로 (ex. /orders)
+	Query  url.Values  // 쿼리 파라미터
+	Form   url.Values  // application/x-www-form-urlencoded 본문
+	JSON   interface{} // application/json 본문
+}
+
+// RetryPolicy GET 요청에 적용되는 재시도 정책
+func (t *Transport) RetryPolicy() retry.Policy {
+	return t.retry
+}
+
+// RemainingReq 그룹의 가장 최근 잔여 요청 수
+func (t *Transport) RemainingReq(group ratelimit.Group) (ratelimit.Remaining, bool) {
+	return t.remaining.Get(group)
+}
+
+// RemainingReqs 모든 그룹의 가장 최근 잔여 요청 수
+func (t *Transport) RemainingReqs() map[ratelimit.Group]ratelimit.Remaining {
+	return t.remaining.All()
+}
+
+// Do 요청을 보내고 응답 본문을 v 로 디코딩한다.
+// GET 요청은 재시도 정책에 따라 재시도하고, 그 외 요청은 한 번만 보낸다.
+func (t *Transport) Do(ctx context.Context, r Request, v interface{}) error {
+	policy := t.retry
+	if r.Method != http.MethodGet {
+		policy = retry.NoRetry
+	}
+	return retry.Do(ctx, policy, func(attempt int) error {
+		return t.send(ctx, r, attempt, v)
+	})
+}
+
+func (t *Transport) send(ctx context.Context, r Request, attempt int, v interface{}) error {
+	u, err := url.Parse(t.baseURL + r.Path)
+	if err != nil {
+		return retry.Permanent(fmt.Errorf("parsing URL: %w", err))
+	}
+
+	if r.Query != nil {
+		u.RawQuery = r.Query.Encode()
+	}
+
+	var bodyReader io.Reader
+	contentType := "application/json"
+	switch {
+	case r.Form != nil:
+		bodyReader = strings.NewReader(r.Form.Encode())
+		contentType = "application/x-www-form-urlencoded"
+	case r.JSON != nil:
+		jsonBody, err := json.Marshal(r.JSON)
+		if err != nil {
+			return retry.Permanent(fmt.Errorf("marshaling request body: %w", err))
+		}
+		bodyReader = bytes.NewReader(jsonBody)
+	}
+
+	if t.limiter != nil {
+		if err := t.limiter.Wait(ctx, ratelimit.GroupOf(r.Method, r.Path)); err != nil {
+			return fmt.Errorf("waiting for rate limit: %w", err)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, r.Method, u.String(), bodyReader)
+	if err != nil {
+		return retry.Permanent(fmt.Errorf("creating request: %w", err))
+	}
+
+	req.Header.Set("Content-Type", contentType)
+	if t.userAgent != "" {
+		req.Header.Set("User-Agent", t.userAgent)
+	}
+	if t.authorize != nil {
+		payload := r.Query
+		if r.Form != nil {
+			payload = r.Form
+		}
+		t.authorize(req, payload)
+	}
+
+	call := &Call{
+		Method:  r.Method,
+		Path:    r.Path,
+		Query:   r.Query,
+		Form:    r.Form,
+		Attempt: attempt,
+		Request: req,
+	}
+	if err := t.beforeSend(ctx, call); err != nil {
+		call.Err = err
+		t.onError(ctx, call)
+		return retry.Permanent(err)
+	}
+
+	err = t.roundTrip(ctx, call, v)
+	if err != nil {
+		call.Err = err
+		t.onError(ctx, call)
+	}
+	return err
+}
+
+func (t *Transport) roundTrip(ctx context.Context, call *Call, v interface{}) error {
+	start := time.Now()
+	resp, err := t.httpClient.Do(call.Request)
+	call.Duration = time.Since(start)
+	if err != nil {
+		return fmt.Errorf("sending request: %w", err)
+	}
+	if remaining, ok := t.remaining.Update(resp.Header); ok && t.limiter != nil {
+		t.limiter.Observe(remaining)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			err = fmt.Errorf("closing response body: %w", err)
+		}
+	}()
+
+	call.StatusCode = resp.StatusCode
+	call.Header = resp.Header
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		call.Err = apierror.FromResponse(resp)
+		t.afterReceive(ctx, call)
+		return call.Err
+	}
+	t.afterReceive(ctx, call)
+
+	if v != nil {
+		if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+			return retry.Permanent(fmt.Errorf("decoding response body: %w", err))
+		}
+	}
+
+	return nil
+}
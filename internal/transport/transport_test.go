@@ -0,0 +1,214 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wooobo/go-upbit-client/pkg/retry"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+func TestTransport_Do(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, "/v1/orders", r.URL.Path)
+		assert.Equal(t, "application/x-www-form-urlencoded", r.Header.Get("Content-Type"))
+		assert.Equal(t, "go-upbit-client/test", r.Header.Get("User-Agent"))
+		assert.Equal(t, "Bearer market=KRW-BTC", r.Header.Get("Authorization"))
+		assert.Equal(t, "first,second", r.Header.Get("X-Middleware"))
+		assert.Equal(t, "market=KRW-BTC", string(body))
+		_, _ = w.Write([]byte(`{"uuid":"1"}`))
+	}))
+	defer server.Close()
+
+	middleware := func(name string) Middleware {
+		return func(next http.RoundTripper) http.RoundTripper {
+			return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				if v := req.Header.Get("X-Middleware"); v != "" {
+					name = v + "," + name
+				}
+				req.Header.Set("X-Middleware", name)
+				return next.RoundTrip(req)
+			})
+		}
+	}
+
+	httpClient := &http.Client{}
+	tr := New("https://invalid.example/v1",
+		WithHTTPClient(httpClient),
+		WithBaseURL(server.URL+"/v1/"),
+		WithUserAgent("go-upbit-client/test"),
+		WithTimeout(time.Second),
+		WithRoundTripper(middleware("first"), middleware("second")),
+		WithAuthorizer(func(req *http.Request, payload url.Values) {
+			req.Header.Set("Authorization", "Bearer "+payload.Encode())
+		}),
+	)
+
+	var resp struct {
+		UUID string `json:"uuid"`
+	}
+	err := tr.Do(context.Background(), Request{
+		Method: http.MethodPost,
+		Path:   "/orders",
+		Form:   url.Values{"market": []string{"KRW-BTC"}},
+	}, &resp)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "1", resp.UUID)
+	assert.Nil(t, httpClient.Transport)
+	assert.Equal(t, time.Duration(0), httpClient.Timeout)
+}
+
+func TestTransport_Do_JSONBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.JSONEq(t, `{"market":"KRW-BTC"}`, string(body))
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	tr := New(server.URL)
+	err := tr.Do(context.Background(), Request{
+		Method: http.MethodPost,
+		Path:   "/orders",
+		JSON:   map[string]string{"market": "KRW-BTC"},
+	}, nil)
+
+	assert.NoError(t, err)
+}
+
+func TestTransport_Interceptors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "trace-1", r.Header.Get("X-Trace-Id"))
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":{"name":"invalid_query_payload","message":"잘못된 요청"}}`))
+	}))
+	defer server.Close()
+
+	var received, failed []*Call
+	tr := New(server.URL, WithInterceptors(Interceptor{
+		BeforeSend: func(ctx context.Context, call *Call) error {
+			call.Request.Header.Set("X-Trace-Id", "trace-1")
+			return nil
+		},
+		AfterReceive: func(ctx context.Context, call *Call) { received = append(received, call) },
+		OnError:      func(ctx context.Context, call *Call) { failed = append(failed, call) },
+	}))
+
+	query := url.Values{"market": []string{"KRW-BTC"}}
+	err := tr.Do(context.Background(), Request{Method: http.MethodDelete, Path: "/order", Query: query}, nil)
+
+	assert.Error(t, err)
+	if assert.Len(t, received, 1) && assert.Len(t, failed, 1) {
+		call := received[0]
+		assert.Equal(t, "/order", call.Path)
+		assert.Equal(t, query, call.Query)
+		assert.Equal(t, 1, call.Attempt)
+		assert.Equal(t, http.StatusBadRequest, call.StatusCode)
+		assert.Greater(t, call.Duration, time.Duration(0))
+		assert.ErrorIs(t, call.Err, err)
+	}
+}
+
+func TestTransport_Interceptors_BeforeSendError(t *testing.T) {
+	var hits int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { hits++ }))
+	defer server.Close()
+
+	for _, method := range []string{http.MethodPost, http.MethodGet} {
+		t.Run(method, func(t *testing.T) {
+			injected := errors.New("injected fault")
+			var before, failed int
+			tr := New(server.URL,
+				WithRetry(retry.Policy{MaxAttempts: 3, BaseDelay: time.Millisecond}),
+				WithInterceptors(Interceptor{
+					BeforeSend: func(ctx context.Context, call *Call) error {
+						before++
+						return injected
+					},
+					OnError: func(ctx context.Context, call *Call) { failed++ },
+				}),
+			)
+
+			err := tr.Do(context.Background(), Request{Method: method, Path: "/orders"}, nil)
+
+			assert.Equal(t, injected, err)
+			assert.Equal(t, 1, before)
+			assert.Equal(t, 1, failed)
+			assert.Equal(t, 0, hits)
+		})
+	}
+}
+
+func TestTransport_Do_MalformedBodyNotRetried(t *testing.T) {
+	var hits int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		_, _ = w.Write([]byte(`{"uuid":`))
+	}))
+	defer server.Close()
+
+	tr := New(server.URL, WithRetry(retry.Policy{MaxAttempts: 3, BaseDelay: time.Millisecond}))
+
+	var resp struct {
+		UUID string `json:"uuid"`
+	}
+	err := tr.Do(context.Background(), Request{Method: http.MethodGet, Path: "/orders"}, &resp)
+
+	assert.ErrorContains(t, err, "decoding response body")
+	assert.Equal(t, 1, hits)
+}
+
+func TestNew_Timeout(t *testing.T) {
+	client := &http.Client{Timeout: 30 * time.Second}
+
+	tr := New("", WithHTTPClient(client), WithTimeout(time.Second))
+	assert.Equal(t, time.Second, tr.httpClient.Timeout)
+
+	tr = New("", WithTimeout(time.Second), WithHTTPClient(client))
+	assert.Equal(t, time.Second, tr.httpClient.Timeout)
+
+	tr = New("", WithHTTPClient(client))
+	assert.Equal(t, 30*time.Second, tr.httpClient.Timeout)
+
+	tr = New("")
+	assert.Equal(t, defaultTimeout, tr.httpClient.Timeout)
+	assert.Equal(t, 30*time.Second, client.Timeout)
+}
+
+func TestWithLogger_Form(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	var failed []*Call
+	tr := New(server.URL,
+		WithLogger(slog.New(slog.NewJSONHandler(&buf, nil))),
+		WithInterceptors(Interceptor{OnError: func(ctx context.Context, call *Call) { failed = append(failed, call) }}),
+	)
+
+	form := url.Values{"uuid": []string{"cancel-me"}}
+	err := tr.Do(context.Background(), Request{Method: http.MethodDelete, Path: "/order", Form: form}, nil)
+
+	assert.Error(t, err)
+	if assert.Len(t, failed, 1) {
+		assert.Equal(t, form, failed[0].Form)
+	}
+	assert.Contains(t, buf.String(), `"form":"uuid=cancel-me"`)
+}
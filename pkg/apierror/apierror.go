@@ -0,0 +1,178 @@
+package apierror
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Error 업비트 API 가 2xx 가 아닌 상태 코드와 함께 반환한 에러
+// 응답 본문 {"error":{"name":"...","message":"..."}} 을 해석한 결과와 요청/응답 정보를 담는다.
+type Error struct {
+	StatusCode int         // HTTP 상태 코드
+	Name       string      // 에러 코드 (ex. insufficient_funds_bid)
+	Message    string      // 에러 메시지
+	Method     string      // 요청 메서드
+	Path       string      // 요청 경로 (ex. /v1/orders)
+	Header     http.Header // 응답 헤더
+	Body       []byte      // 원본 응답 본문
+}
+
+func (e *Error) Error() string {
+	var b strings.Builder
+	b.WriteString("upbit: ")
+	if e.Method != "" || e.Path != "" {
+		b.WriteString(strings.TrimSpace(e.Method + " " + e.Path))
+		b.WriteString(": ")
+	}
+	b.WriteString(strconv.Itoa(e.StatusCode))
+	if e.Name != "" {
+		b.WriteString(" ")
+		b.WriteString(e.Name)
+	}
+	switch {
+	case e.Message != "":
+		b.WriteString(": ")
+		b.WriteString(e.Message)
+	case e.Name == "" && len(e.Body) > 0:
+		b.WriteString(": ")
+		b.WriteString(string(e.Body))
+	}
+	return b.String()
+}
+
+// Is 에러 코드가 같으면 같은 에러로 취급한다.
+// 에러 코드가 없는 target 은 상태 코드로 비교한다.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	if !ok {
+		return false
+	}
+	if t.Name != "" {
+		return t.Name == e.Name
+	}
+	return t.StatusCode != 0 && t.StatusCode == e.StatusCode
+}
+
+// 상태 코드 에러
+var (
+	ErrBadRequest          = &Error{StatusCode: http.StatusBadRequest}
+	ErrUnauthorized        = &Error{StatusCode: http.StatusUnauthorized}
+	ErrForbidden           = &Error{StatusCode: http.StatusForbidden}
+	ErrNotFound            = &Error{StatusCode: http.StatusNotFound}
+	ErrTooManyRequests     = &Error{StatusCode: http.StatusTooManyRequests}
+	ErrInternalServerError = &Error{StatusCode: http.StatusInternalServerError}
+)
+
+// 공통 및 인증 에러
+var (
+	ErrValidation          = &Error{Name: "validation_error"}      // 잘못된 API 요청
+	ErrInvalidQueryPayload = &Error{Name: "invalid_query_payload"} // JWT 헤더의 페이로드가 올바르지 않음
+	ErrJWTVerification     = &Error{Name: "jwt_verification"}      // JWT 토큰 검증 실패
+	ErrExpiredAccessKey    = &Error{Name: "expired_access_key"}    // API 키가 만료됨
+	ErrNonceUsed           = &Error{Name: "nonce_used"}            // 이미 요청한 nonce 값이 다시 사용됨
+	ErrNoAuthorizationIP   = &Error{Name: "no_authorization_i_p"}  // 허용되지 않은 IP 주소
+	ErrOutOfScope          = &Error{Name: "out_of_scope"}          // 허용되지 않은 기능
+)
+
+// 주문 에러
+var (
+	ErrCreateAskError       = &Error{Name: "create_ask_error"}       // 매도 주문 생성 실패
+	ErrCreateBidError       = &Error{Name: "create_bid_error"}       // 매수 주문 생성 실패
+	ErrInsufficientFundsAsk = &Error{Name: "insufficient_funds_ask"} // 매도 가능 잔고 부족
+	ErrInsufficientFundsBid = &Error{Name: "insufficient_funds_bid"} // 매수 가능 잔고 부족
+	ErrUnderMinTotalAsk     = &Error{Name: "under_min_total_ask"}    // 최소 매도 금액 미만
+	ErrUnderMinTotalBid     = &Error{Name: "under_min_total_bid"}    // 최소 매수 금액 미만
+	ErrInvalidPriceAsk      = &Error{Name: "invalid_price_ask"}      // 잘못된 매도 가격 (호가 단위 불일치 등)
+	ErrInvalidPriceBid      = &Error{Name: "invalid_price_bid"}      // 잘못된 매수 가격 (호가 단위 불일치 등)
+	ErrInvalidVolumeAsk     = &Error{Name: "invalid_volume_ask"}     // 잘못된 매도 수량
+	ErrInvalidVolumeBid     = &Error{Name: "invalid_volume_bid"}     // 잘못된 매수 수량
+	ErrOrderNotFound        = &Error{Name: "order_not_found"}        // 주문을 찾을 수 없음
+)
+
+// 입출금 에러
+var (
+	ErrWithdrawAddressNotRegistered = &Error{Name: "withdraw_address_not_registerd"} // 등록되지 않은 출금 주소
+)
+
+type errorBody struct {
+	Error struct {
+		Name    json.RawMessage `json:"name"`
+		Message string          `json:"message"`
+	} `json:"error"`
+}
+
+// FromResponse 실패한 응답으로부터 *Error 를 만든다. 응답 본문은 모두 읽지만 닫지는 않는다.
+func FromResponse(resp *http.Response) *Error {
+	body, _ := io.ReadAll(resp.Body)
+
+	apiErr := &Error{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+		Body:       body,
+	}
+	if resp.Request != nil {
+		apiErr.Method = resp.Request.Method
+		if resp.Request.URL != nil {
+			apiErr.Path = resp.Request.URL.Path
+		}
+	}
+
+	var eb errorBody
+	if err := json.Unmarshal(body, &eb); err == nil {
+		apiErr.Name = parseName(eb.Error.Name)
+		apiErr.Message = eb.Error.Message
+	}
+
+	return apiErr
+}
+
+// 업비트는 에러 코드를 문자열로 주지만 시세 API 의 일부 에러는 숫자로 준다. (ex. {"name":404})
+func parseName(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+	var name string
+	if err := json.Unmarshal(raw, &name); err == nil {
+		return name
+	}
+	var num json.Number
+	if err := json.Unmarshal(raw, &num); err == nil {
+		return num.String()
+	}
+	return ""
+}
+
+// As err 체인에서 *Error 를 찾는다.
+func As(err error) (*Error, bool) {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	return nil, false
+}
+
+// HasName err 가 주어진 에러 코드 중 하나를 가진 *Error 인지 확인한다.
+func HasName(err error, names ...string) bool {
+	apiErr, ok := As(err)
+	if !ok {
+		return false
+	}
+	for _, name := range names {
+		if apiErr.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// StatusCode err 가 *Error 이면 HTTP 상태 코드를, 아니면 0 을 반환한다.
+func StatusCode(err error) int {
+	if apiErr, ok := As(err); ok {
+		return apiErr.StatusCode
+	}
+	return 0
+}
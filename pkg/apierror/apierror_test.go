@@ -0,0 +1,83 @@
+package apierror
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testResponse(status int, body string) *http.Response {
+	u, _ := url.Parse("https://api.upbit.com/v1/orders")
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Remaining-Req": []string{"group=order; min=1; sec=7"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    &http.Request{Method: http.MethodPost, URL: u},
+	}
+}
+
+func TestFromResponse(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		wantName    string
+		wantMessage string
+	}{
+		{
+			name:        "string name",
+			status:      http.StatusBadRequest,
+			body:        `{"error":{"name":"insufficient_funds_bid","message":"주문가능한 금액(KRW)이 부족합니다."}}`,
+			wantName:    "insufficient_funds_bid",
+			wantMessage: "주문가능한 금액(KRW)이 부족합니다.",
+		},
+		{
+			name:        "numeric name",
+			status:      http.StatusNotFound,
+			body:        `{"error":{"name":404,"message":"Code not found"}}`,
+			wantName:    "404",
+			wantMessage: "Code not found",
+		},
+		{
+			name:   "plain text body",
+			status: http.StatusTooManyRequests,
+			body:   "Too many API requests.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := FromResponse(testResponse(tt.status, tt.body))
+
+			assert.Equal(t, tt.status, actual.StatusCode)
+			assert.Equal(t, tt.wantName, actual.Name)
+			assert.Equal(t, tt.wantMessage, actual.Message)
+			assert.Equal(t, http.MethodPost, actual.Method)
+			assert.Equal(t, "/v1/orders", actual.Path)
+			assert.Equal(t, "group=order; min=1; sec=7", actual.Header.Get("Remaining-Req"))
+		})
+	}
+}
+
+func TestError_Is(t *testing.T) {
+	err := fmt.Errorf("failed to place order: %w", FromResponse(testResponse(http.StatusBadRequest,
+		`{"error":{"name":"under_min_total_bid","message":"최소주문금액 이상으로 주문해주세요"}}`)))
+
+	assert.ErrorIs(t, err, ErrUnderMinTotalBid)
+	assert.ErrorIs(t, err, ErrBadRequest)
+	assert.False(t, errors.Is(err, ErrInsufficientFundsBid))
+	assert.False(t, errors.Is(err, ErrTooManyRequests))
+
+	assert.True(t, HasName(err, "insufficient_funds_bid", "under_min_total_bid"))
+	assert.Equal(t, http.StatusBadRequest, StatusCode(err))
+
+	apiErr, ok := As(err)
+	assert.True(t, ok)
+	assert.Equal(t, "upbit: POST /v1/orders: 400 under_min_total_bid: 최소주문금액 이상으로 주문해주세요", apiErr.Error())
+}
@@ -0,0 +1,370 @@
+package decimal
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal 가격, 수량, 잔고를 오차 없이 표현하는 10진수
+// 값은 coef / 10^scale 이며, 제로 값은 0 으로 바로 사용할 수 있다.
+// 모든 연산은 새 값을 반환하고 원래 값을 바꾸지 않는다.
+type Decimal struct {
+	coef  *big.Int
+	scale int32
+}
+
+// Zero 0
+var Zero = Decimal{}
+
+// maxScale Parse 가 허용하는 scale 의 절댓값. 지수가 너무 크면 계산에 메모리와 시간이 과도하게 든다.
+const maxScale = 1000
+
+// NewFromInt 정수로 Decimal 을 만든다.
+func NewFromInt(v int64) Decimal {
+	return Decimal{coef: big.NewInt(v)}
+}
+
+// New coef * 10^-scale 값을 만든다. (ex. New(12345, 2) == 123.45)
+func New(coef int64, scale int32) Decimal {
+	d := Decimal{coef: big.NewInt(coef)}
+	if scale < 0 {
+		return d.mulPow10(-scale)
+	}
+	return d.withScale(scale)
+}
+
+// NewFromFloat float64 를 가장 짧은 10진수 표현으로 변환한다. (ex. 0.1 → "0.1")
+func NewFromFloat(v float64) Decimal {
+	d, err := Parse(strconv.FormatFloat(v, 'f', -1, 64))
+	if err != nil {
+		return Zero
+	}
+	return d
+}
+
+// Parse "123.45", "-0.0001", "1e-5" 형식의 문자열을 해석한다.
+func Parse(s string) (Decimal, error) {
+	str := strings.TrimSpace(s)
+	if str == "" {
+		return Zero, fmt.Errorf("decimal: empty string")
+	}
+
+	mantissa, exp := str, int64(0)
+	if i := strings.IndexAny(str, "eE"); i >= 0 {
+		mantissa = str[:i]
+		e, err := strconv.ParseInt(str[i+1:], 10, 32)
+		if err != nil {
+			return Zero, fmt.Errorf("decimal: invalid exponent in %q", s)
+		}
+		exp = e
+	}
+
+	neg := false
+	switch {
+	case strings.HasPrefix(mantissa, "-"):
+		neg = true
+		mantissa = mantissa[1:]
+	case strings.HasPrefix(mantissa, "+"):
+		mantissa = mantissa[1:]
+	}
+
+	intPart, fracPart, _ := strings.Cut(mantissa, ".")
+	digits := intPart + fracPart
+	if digits == "" || strings.IndexFunc(digits, func(r rune) bool { return r < '0' || r > '9' }) >= 0 {
+		return Zero, fmt.Errorf("decimal: invalid number %q", s)
+	}
+
+	coef, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return Zero, fmt.Errorf("decimal: invalid number %q", s)
+	}
+	if neg {
+		coef.Neg(coef)
+	}
+
+	scale := int64(len(fracPart)) - exp
+	if scale > maxScale || scale < -maxScale {
+		return Zero, fmt.Errorf("decimal: exponent out of range in %q", s)
+	}
+	d := Decimal{coef: coef}
+	if scale < 0 {
+		return d.withScale(0).mulPow10(int32(-scale)), nil
+	}
+	return Decimal{coef: coef, scale: int32(scale)}, nil
+}
+
+// MustParse Parse 와 같지만 실패하면 panic 한다. 상수 값에만 사용한다.
+func MustParse(s string) Decimal {
+	d, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func (d Decimal) value() *big.Int {
+	if d.coef == nil {
+		return new(big.Int)
+	}
+	return d.coef
+}
+
+func (d Decimal) withScale(scale int32) Decimal {
+	return Decimal{coef: d.value(), scale: scale}
+}
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+func (d Decimal) mulPow10(n int32) Decimal {
+	return Decimal{coef: new(big.Int).Mul(d.value(), pow10(n)), scale: d.scale}
+}
+
+// rescaleTo scale 을 늘린다. 값은 바뀌지 않는다.
+func (d Decimal) rescaleTo(scale int32) Decimal {
+	if scale <= d.scale {
+		return d.withScale(d.scale)
+	}
+	return Decimal{coef: new(big.Int).Mul(d.value(), pow10(scale-d.scale)), scale: scale}
+}
+
+func align(a, b Decimal) (Decimal, Decimal) {
+	if a.scale > b.scale {
+		return a, b.rescaleTo(a.scale)
+	}
+	return a.rescaleTo(b.scale), b
+}
+
+func (d Decimal) Add(d2 Decimal) Decimal {
+	a, b := align(d, d2)
+	return Decimal{coef: new(big.Int).Add(a.value(), b.value()), scale: a.scale}
+}
+
+func (d Decimal) Sub(d2 Decimal) Decimal {
+	a, b := align(d, d2)
+	return Decimal{coef: new(big.Int).Sub(a.value(), b.value()), scale: a.scale}
+}
+
+func (d Decimal) Mul(d2 Decimal) Decimal {
+	return Decimal{coef: new(big.Int).Mul(d.value(), d2.value()), scale: d.scale + d2.scale}
+}
+
+// Quo d / d2 를 소수점 places 자리에서 버린 값. d2 가 0 이면 panic 한다.
+func (d Decimal) Quo(d2 Decimal, places int32) Decimal {
+	if places < 0 {
+		places = 0
+	}
+	num := new(big.Int).Mul(d.value(), pow10(d2.scale+places))
+	den := new(big.Int).Mul(d2.value(), pow10(d.scale))
+	return Decimal{coef: num.Quo(num, den), scale: places}
+}
+
+func (d Decimal) Neg() Decimal {
+	return Decimal{coef: new(big.Int).Neg(d.value()), scale: d.scale}
+}
+
+func (d Decimal) Abs() Decimal {
+	return Decimal{coef: new(big.Int).Abs(d.value()), scale: d.scale}
+}
+
+// Cmp d < d2 이면 -1, 같으면 0, d > d2 이면 1
+func (d Decimal) Cmp(d2 Decimal) int {
+	a, b := align(d, d2)
+	return a.value().Cmp(b.value())
+}
+
+func (d Decimal) Equal(d2 Decimal) bool              { return d.Cmp(d2) == 0 }
+func (d Decimal) LessThan(d2 Decimal) bool           { return d.Cmp(d2) < 0 }
+func (d Decimal) LessThanOrEqual(d2 Decimal) bool    { return d.Cmp(d2) <= 0 }
+func (d Decimal) GreaterThan(d2 Decimal) bool        { return d.Cmp(d2) > 0 }
+func (d Decimal) GreaterThanOrEqual(d2 Decimal) bool { return d.Cmp(d2) >= 0 }
+func (d Decimal) Sign() int                          { return d.value().Sign() }
+func (d Decimal) IsZero() bool                       { return d.Sign() == 0 }
+func (d Decimal) IsNegative() bool                   { return d.Sign() < 0 }
+func (d Decimal) IsPositive() bool                   { return d.Sign() > 0 }
+
+// Min 둘 중 작은 값
+func Min(a, b Decimal) Decimal {
+	if a.LessThan(b) {
+		return a
+	}
+	return b
+}
+
+// Max 둘 중 큰 값
+func Max(a, b Decimal) Decimal {
+	if a.GreaterThan(b) {
+		return a
+	}
+	return b
+}
+
+type roundMode int
+
+const (
+	roundHalfUp roundMode = iota // 0.5 는 0 에서 먼 쪽으로
+	roundDown                    // 0 방향으로 (버림)
+	roundFloor                   // 음의 무한대 방향으로 (내림)
+	roundCeil                    // 양의 무한대 방향으로 (올림)
+)
+
+// roundQuo q, r = a / b 를 mode 에 따라 정수로 반올림한 몫
+func roundQuo(a, b *big.Int, mode roundMode) *big.Int {
+	q, r := new(big.Int).QuoRem(a, b, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+	// 나머지 부호는 a 를 따르고 b 는 항상 양수이다.
+	switch mode {
+	case roundHalfUp:
+		twice := new(big.Int).Mul(new(big.Int).Abs(r), big.NewInt(2))
+		if twice.Cmp(b) >= 0 {
+			q.Add(q, big.NewInt(int64(r.Sign())))
+		}
+	case roundFloor:
+		if r.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		}
+	case roundCeil:
+		if r.Sign() > 0 {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return q
+}
+
+func (d Decimal) round(places int32, mode roundMode) Decimal {
+	if places < 0 {
+		places = 0
+	}
+	if d.scale <= places {
+		return d.withScale(d.scale)
+	}
+	q := roundQuo(d.value(), pow10(d.scale-places), mode)
+	return Decimal{coef: q, scale: places}
+}
+
+// Round 소수점 places 자리로 반올림한다. (0.5 는 0 에서 먼 쪽으로)
+func (d Decimal) Round(places int32) Decimal { return d.round(places, roundHalfUp) }
+
+// Truncate 소수점 places 자리 아래를 버린다. (0 방향)
+func (d Decimal) Truncate(places int32) Decimal { return d.round(places, roundDown) }
+
+// Floor 소수점 places 자리로 내림한다. (음의 무한대 방향)
+func (d Decimal) Floor(places int32) Decimal { return d.round(places, roundFloor) }
+
+// Ceil 소수점 places 자리로 올림한다. (양의 무한대 방향)
+func (d Decimal) Ceil(places int32) Decimal { return d.round(places, roundCeil) }
+
+func (d Decimal) roundStep(step Decimal, mode roundMode) Decimal {
+	if step.Sign() <= 0 {
+		return d
+	}
+	a, s := align(d, step)
+	q := roundQuo(a.value(), s.value(), mode)
+	return Decimal{coef: q.Mul(q, s.value()), scale: s.scale}
+}
+
+// RoundStep step 의 배수 중 가장 가까운 값 (0.5 는 0 에서 먼 쪽으로). step 이 0 이하이면 d 를 그대로 반환한다.
+func (d Decimal) RoundStep(step Decimal) Decimal { return d.roundStep(step, roundHalfUp) }
+
+// FloorStep step 의 배수 중 d 보다 작거나 같은 가장 큰 값
+func (d Decimal) FloorStep(step Decimal) Decimal { return d.roundStep(step, roundFloor) }
+
+// CeilStep step 의 배수 중 d 보다 크거나 같은 가장 작은 값
+func (d Decimal) CeilStep(step Decimal) Decimal { return d.roundStep(step, roundCeil) }
+
+// Float64 가장 가까운 float64 값
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// String 소수점 아래 불필요한 0 을 뺀 10진수 표기 (ex. "0.0001", "80000000")
+func (d Decimal) String() string {
+	s := d.format()
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	if s == "-0" {
+		return "0"
+	}
+	return s
+}
+
+// StringFixed 소수점 places 자리로 반올림해 자릿수를 맞춘 표기 (ex. StringFixed(2) → "1.50")
+func (d Decimal) StringFixed(places int32) string {
+	if places < 0 {
+		places = 0
+	}
+	return d.Round(places).rescaleTo(places).format()
+}
+
+func (d Decimal) format() string {
+	v := d.value()
+	digits := new(big.Int).Abs(v).String()
+	if d.scale > 0 {
+		if pad := int(d.scale) + 1 - len(digits); pad > 0 {
+			digits = strings.Repeat("0", pad) + digits
+		}
+		point := len(digits) - int(d.scale)
+		digits = digits[:point] + "." + digits[point:]
+	}
+	if v.Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+// MarshalJSON 정밀도를 잃지 않도록 문자열로 인코딩한다.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(d.String())), nil
+}
+
+// UnmarshalJSON 숫자(1.5), 문자열("1.5"), null, 빈 문자열을 모두 받는다. null 과 빈 문자열은 0 이다.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		*d = Zero
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		if s == "" {
+			*d = Zero
+			return nil
+		}
+		data = []byte(s)
+	}
+	parsed, err := Parse(string(data))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Decimal) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*d = Zero
+		return nil
+	}
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
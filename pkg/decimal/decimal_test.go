@@ -0,0 +1,106 @@
+package decimal
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "0", want: "0"},
+		{in: "80000000", want: "80000000"},
+		{in: "80000000.0", want: "80000000"},
+		{in: "0.0001", want: "0.0001"},
+		{in: "-12.3400", want: "-12.34"},
+		{in: "+5", want: "5"},
+		{in: ".5", want: "0.5"},
+		{in: "1e-5", want: "0.00001"},
+		{in: "1.5E3", want: "1500"},
+		{in: "", wantErr: true},
+		{in: "abc", wantErr: true},
+		{in: "1.2.3", wantErr: true},
+		{in: "-", wantErr: true},
+		{in: "1e2000000000", wantErr: true},
+		{in: "0.0000000001e-2147483648", wantErr: true},
+		{in: "1e1000", want: "1" + strings.Repeat("0", 1000)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			actual, err := Parse(tt.in)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, actual.String())
+		})
+	}
+}
+
+func TestDecimal_Arithmetic(t *testing.T) {
+	a := MustParse("0.1")
+	b := MustParse("0.2")
+
+	assert.Equal(t, "0.3", a.Add(b).String())
+	assert.True(t, a.Add(b).Equal(MustParse("0.3")))
+	assert.Equal(t, "-0.1", a.Sub(b).String())
+	assert.Equal(t, "0.02", a.Mul(b).String())
+	assert.Equal(t, "0.3333", MustParse("1").Quo(MustParse("3"), 4).String())
+	assert.Equal(t, "5", Zero.Add(NewFromInt(5)).String())
+	assert.Equal(t, "123.45", New(12345, 2).String())
+	assert.Equal(t, "0.1", NewFromFloat(0.1).String())
+
+	assert.Equal(t, -1, a.Cmp(b))
+	assert.True(t, b.GreaterThan(a))
+	assert.True(t, Zero.IsZero())
+	assert.Equal(t, a, Min(a, b))
+	assert.Equal(t, b, Max(a, b))
+}
+
+func TestDecimal_Round(t *testing.T) {
+	d := MustParse("1.2345")
+	assert.Equal(t, "1.235", d.Round(3).String())
+	assert.Equal(t, "1.234", d.Truncate(3).String())
+	assert.Equal(t, "1.234", d.Floor(3).String())
+	assert.Equal(t, "1.235", d.Ceil(3).String())
+	assert.Equal(t, "-1.235", d.Neg().Round(3).String())
+	assert.Equal(t, "-1.235", d.Neg().Floor(3).String())
+	assert.Equal(t, "-1.234", d.Neg().Ceil(3).String())
+	assert.Equal(t, "1.50", MustParse("1.5").StringFixed(2))
+
+	step := MustParse("0.05")
+	assert.Equal(t, "1.25", d.RoundStep(step).String())
+	assert.Equal(t, "1.2", d.FloorStep(step).String())
+	assert.Equal(t, "1.25", d.CeilStep(step).String())
+	assert.Equal(t, "80001000", MustParse("80000500").RoundStep(NewFromInt(1000)).String())
+}
+
+func TestDecimal_JSON(t *testing.T) {
+	var v struct {
+		Str   Decimal `json:"str"`
+		Num   Decimal `json:"num"`
+		Null  Decimal `json:"null"`
+		Empty Decimal `json:"empty"`
+	}
+	err := json.Unmarshal([]byte(`{"str":"0.30000000","num":93000000.5,"null":null,"empty":""}`), &v)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "0.3", v.Str.String())
+	assert.Equal(t, "93000000.5", v.Num.String())
+	assert.True(t, v.Null.IsZero())
+	assert.True(t, v.Empty.IsZero())
+
+	out, err := json.Marshal(v.Num)
+	assert.NoError(t, err)
+	assert.Equal(t, `"93000000.5"`, string(out))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"num":1e2000000000}`), &v))
+}
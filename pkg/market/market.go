@@ -0,0 +1,99 @@
+package market
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// QuoteCurrency 마켓의 기준 화폐 (ex. KRW-BTC 의 KRW)
+type QuoteCurrency string
+
+const (
+	KRW  QuoteCurrency = "KRW"
+	BTC  QuoteCurrency = "BTC"
+	USDT QuoteCurrency = "USDT"
+)
+
+// QuoteCurrencies 업비트가 지원하는 기준 화폐
+var QuoteCurrencies = []QuoteCurrency{KRW, BTC, USDT}
+
+// Valid 지원하는 기준 화폐인지 확인한다.
+func (q QuoteCurrency) Valid() bool {
+	return slices.Contains(QuoteCurrencies, q)
+}
+
+var ErrInvalidCode = errors.New("invalid market code")
+
+// Code 마켓 코드 (ex. KRW-BTC). "{기준 화폐}-{거래 대상 화폐}" 형식이다.
+type Code string
+
+// NewCode 기준 화폐와 거래 대상 화폐로 마켓 코드를 만든다.
+func NewCode(quote QuoteCurrency, base string) Code {
+	return Code(string(quote) + "-" + base)
+}
+
+// ParseCode 앞뒤 공백을 제거하고 대문자로 바꾼 뒤 검증한다. (ex. " krw-btc" -> KRW-BTC)
+func ParseCode(s string) (Code, error) {
+	code := Code(strings.ToUpper(strings.TrimSpace(s)))
+	if err := code.Validate(); err != nil {
+		return "", err
+	}
+	return code, nil
+}
+
+// MustParseCode ParseCode 와 같지만 잘못된 코드이면 panic 한다.
+func MustParseCode(s string) Code {
+	code, err := ParseCode(s)
+	if err != nil {
+		panic(err)
+	}
+	return code
+}
+
+// Validate 지원하는 기준 화폐와 영문 대문자, 숫자로 된 거래 대상 화폐로 이루어졌는지 확인한다.
+func (c Code) Validate() error {
+	quote, base, ok := strings.Cut(string(c), "-")
+	if !ok {
+		return fmt.Errorf("%w: %q, must be QUOTE-BASE (ex. KRW-BTC)", ErrInvalidCode, string(c))
+	}
+	if !QuoteCurrency(quote).Valid() {
+		return fmt.Errorf("%w: %q, unsupported quote currency %q", ErrInvalidCode, string(c), quote)
+	}
+	if base == "" {
+		return fmt.Errorf("%w: %q, empty base currency", ErrInvalidCode, string(c))
+	}
+	for _, r := range base {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return fmt.Errorf("%w: %q, invalid base currency %q", ErrInvalidCode, string(c), base)
+		}
+	}
+	return nil
+}
+
+// Quote 기준 화폐 (ex. KRW-BTC 의 KRW)
+func (c Code) Quote() QuoteCurrency {
+	quote, _, _ := strings.Cut(string(c), "-")
+	return QuoteCurrency(quote)
+}
+
+// Base 거래 대상 화폐 (ex. KRW-BTC 의 BTC)
+func (c Code) Base() string {
+	_, base, _ := strings.Cut(string(c), "-")
+	return base
+}
+
+func (c Code) String() string { return string(c) }
+
+// Strings 모든 코드를 검증하고 문자열 목록으로 바꾼다.
+func Strings(codes []Code) ([]string, error) {
+	values := make([]string, len(codes))
+	for i, code := range codes {
+		if err := code.Validate(); err != nil {
+			return nil, err
+		}
+		values[i] = string(code)
+	}
+	return values, nil
+}
@@ -0,0 +1,53 @@
+package market
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCode(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    Code
+		wantErr bool
+	}{
+		{value: "KRW-BTC", want: "KRW-BTC"},
+		{value: " btc-eth ", want: "BTC-ETH"},
+		{value: "USDT-1INCH", want: "USDT-1INCH"},
+		{value: "KRWBTC", wantErr: true},
+		{value: "EUR-BTC", wantErr: true},
+		{value: "KRW-", wantErr: true},
+		{value: "KRW-BTC, BTC-ETH", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			actual, err := ParseCode(tt.value)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidCode)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, actual)
+		})
+	}
+}
+
+func TestCode_QuoteBase(t *testing.T) {
+	code := NewCode(KRW, "BTC")
+
+	assert.Equal(t, Code("KRW-BTC"), code)
+	assert.Equal(t, KRW, code.Quote())
+	assert.Equal(t, "BTC", code.Base())
+	assert.Error(t, Code("krw-btc").Validate())
+}
+
+func TestStrings(t *testing.T) {
+	actual, err := Strings([]Code{"KRW-BTC", "BTC-ETH"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"KRW-BTC", "BTC-ETH"}, actual)
+
+	_, err = Strings([]Code{"KRW-BTC", "KRW-BTC,BTC-ETH"})
+	assert.ErrorIs(t, err, ErrInvalidCode)
+}
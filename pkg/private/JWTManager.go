@@ -5,9 +5,12 @@ import (
 	"encoding/hex"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
+	"log/slog"
 	"net/url"
 )
 
+const redacted = "[REDACTED]"
+
 type JWTManager struct {
 	AccessKey string
 	SecretKey string
@@ -20,6 +23,11 @@ func NewJWT(accessKey, secretKey string) *JWTManager {
 	}
 }
 
+// LogValue 로그에 access key, secret key 가 남지 않도록 가린다.
+func (j *JWTManager) LogValue() slog.Value {
+	return slog.StringValue(redacted)
+}
+
 func (j *JWTManager) getSHA512Hash(query string) string {
 	hash := sha512.Sum512([]byte(query))
 	return hex.EncodeToString(hash[:])
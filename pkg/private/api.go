@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"net/url"
 	"strconv"
+
+	"github.com/wooobo/go-upbit-client/pkg/retry"
+	"github.com/wooobo/go-upbit-client/pkg/ticksize"
 )
 
 // GetAccounts 전체 계좌 조회
@@ -22,11 +25,14 @@ func (c *Client) GetAccounts(ctx context.Context) ([]Account, error) {
 }
 
 // GetOrderChance 마켓별 주문 가능 정보를 확인한다.
-func (c *Client) GetOrderChance(ctx context.Context, market string) (OrderChance, error) {
+func (c *Client) GetOrderChance(ctx context.Context, market MarketCode) (OrderChance, error) {
+	if err := market.Validate(); err != nil {
+		return OrderChance{}, err
+	}
 	path := "/orders/chance"
 
 	values := url.Values{}
-	values.Set("market", market)
+	values.Set("market", string(market))
 
 	var resp OrderChance
 	err := c.Get(ctx, path, values, &resp)
@@ -54,9 +60,12 @@ func (c *Client) GetFilledOrder(ctx context.Context, uuid string) (FilledOrder,
 
 // GetOrdersByIdentifier 주문 UUID 로 주문 정보 조회
 func (c *Client) GetOrdersByIdentifier(ctx context.Context, req OrderSearchRequest) ([]Order, error) {
+	if err := validateMarket(req.Market); err != nil {
+		return nil, err
+	}
 	path := "/orders/uuids"
 	values := url.Values{}
-	values.Set("market", req.Market)
+	values.Set("market", string(req.Market))
 	values.Set("OrderBy", req.OrderBy)
 
 	for _, uuid := range req.UUIDs {
@@ -78,10 +87,13 @@ func (c *Client) GetOrdersByIdentifier(ctx context.Context, req OrderSearchReque
 
 // GetOpenOrders 미체결 주문 조회
 func (c *Client) GetOpenOrders(ctx context.Context, req OrderQueryParams) ([]Order, error) {
+	if err := validateMarket(req.Market); err != nil {
+		return nil, err
+	}
 	path := "/orders/open"
 
 	values := url.Values{}
-	values.Set("market", req.Market)
+	values.Set("market", string(req.Market))
 	values.Set("limit", fmt.Sprint(req.Limit))
 
 	if req.Limit > 0 {
@@ -118,10 +130,13 @@ func (c *Client) GetOpenOrders(ctx context.Context, req OrderQueryParams) ([]Ord
 // start_time 과 end_time 을 둘 다 정의할 경우 최대 1시간 범위까지의 주문만 조회가 가능합니다.
 // *조회 시간 내의 주문 건이라도 limit 개수를 초과한 범위일 경우 조회되지 않으니 이 경우 나누어서 조회하여야 합니다.
 func (c *Client) GetClosedOrder(ctx context.Context, req CompletedOrderRequest) ([]Order, error) {
+	if err := validateMarket(req.Market); err != nil {
+		return nil, err
+	}
 	path := "/orders/closed"
 
 	values := url.Values{}
-	values.Set("market", req.Market)
+	values.Set("market", string(req.Market))
 	values.Set("limit", strconv.Itoa(req.Limit))
 	values.Set("order_by", req.OrderBy.String())
 
@@ -151,25 +166,74 @@ func (c *Client) GetClosedOrder(ctx context.Context, req CompletedOrderRequest)
 }
 
 // PlaceOrder 주문하기
+// 요청을 보내기 전에 PlaceOrderRequest.Validate 로 주문 타입별 필드 조합을 확인한다.
+// Identifier 가 지정된 경우에만 429, 5xx, 네트워크 에러에 대해 재시도하며,
+// 재시도 전에 GetOrdersByIdentifier 로 이미 접수된 주문이 있으면 다시 주문하지 않고 그 주문을 반환한다.
+// Config.ValidateTickSize 가 true 이면 지정가 주문 가격이 호가 단위에 맞지 않을 때 요청하지 않고 ticksize.ErrInvalidTick 을 반환한다.
+// Config.PreTradeCheck 가 true 이면 CheckOrder 를 통과한 주문만 보낸다.
 func (c *Client) PlaceOrder(ctx context.Context, order PlaceOrderRequest) (PlaceOrder, error) {
+	if err := order.Validate(); err != nil {
+		return PlaceOrder{}, err
+	}
+	if c.validateTickSize && order.OrdType == OrdTypeLimit {
+		if err := ticksize.Validate(order.Market, order.Price); err != nil {
+			return PlaceOrder{}, err
+		}
+	}
+	if c.preTradeCheck {
+		if err := c.CheckOrder(ctx, order); err != nil {
+			return PlaceOrder{}, err
+		}
+		// 주문이 접수되면 잔고가 바뀌므로 다음 확인 때 주문 가능 정보를 다시 받는다.
+		defer c.InvalidateOrderChance(order.Market)
+	}
 	path := "/orders"
 
 	values := url.Values{}
-	values.Set("market", order.Market)
+	values.Set("market", string(order.Market))
 	values.Set("side", order.Side.String())
-	values.Set("volume", order.Volume)
-	values.Set("price", order.Price)
-	values.Set("ord_type", order.OrdType)
+	values.Set("ord_type", order.OrdType.String())
 
+	// 주문 타입에 따라 비어 있어야 하는 필드는 보내지 않는다.
+	if !order.Volume.IsZero() {
+		values.Set("volume", order.Volume.String())
+	}
+	if !order.Price.IsZero() {
+		values.Set("price", order.Price.String())
+	}
 	if order.TimeInForce != "" {
-		values.Set("time_in_force", order.TimeInForce)
+		values.Set("time_in_force", order.TimeInForce.String())
+	}
+	if order.SMPType != "" {
+		values.Set("smp_type", order.SMPType.String())
 	}
 	if order.Identifier != "" {
 		values.Set("identifier", order.Identifier)
 	}
 
 	var resp PlaceOrder
-	err := c.Post(ctx, path, values, &resp)
+	if order.Identifier == "" {
+		err := c.Post(ctx, path, values, &resp)
+		if err != nil {
+			return PlaceOrder{}, fmt.Errorf("failed to place order: %w", err)
+		}
+		return resp, nil
+	}
+
+	// Identifier 가 있으면 재시도 전에 이전 시도로 이미 접수된 주문이 있는지 확인한다.
+	err := retry.Do(ctx, c.transport.RetryPolicy(), func(attempt int) error {
+		if attempt > 1 {
+			placed, found, err := c.findOrderByIdentifier(ctx, order.Market, order.Identifier)
+			if err != nil {
+				return retry.Permanent(fmt.Errorf("checking duplicate order: %w", err))
+			}
+			if found {
+				resp = placed
+				return nil
+			}
+		}
+		return c.Post(ctx, path, values, &resp)
+	})
 	if err != nil {
 		return PlaceOrder{}, fmt.Errorf("failed to place order: %w", err)
 	}
@@ -177,6 +241,42 @@ func (c *Client) PlaceOrder(ctx context.Context, order PlaceOrderRequest) (Place
 	return resp, nil
 }
 
+func (c *Client) findOrderByIdentifier(ctx context.Context, market MarketCode, identifier string) (PlaceOrder, bool, error) {
+	orders, err := c.GetOrdersByIdentifier(ctx, OrderSearchRequest{
+		Market:      market,
+		Identifiers: []string{identifier},
+	})
+	if err != nil {
+		return PlaceOrder{}, false, err
+	}
+	if len(orders) == 0 {
+		return PlaceOrder{}, false, nil
+	}
+
+	o := orders[0]
+	return PlaceOrder{
+		UUID:            o.UUID,
+		Side:            o.Side,
+		OrdType:         o.OrdType,
+		Price:           o.Price,
+		State:           o.State,
+		Market:          o.Market,
+		CreatedAt:       o.CreatedAt,
+		Volume:          o.Volume,
+		RemainingVolume: o.RemainingVolume,
+		ReservedFee:     o.ReservedFee,
+		RemainingFee:    o.RemainingFee,
+		PaidFee:         o.PaidFee,
+		Locked:          o.Locked,
+		ExecutedVolume:  o.ExecutedVolume,
+		TradesCount:     o.TradesCount,
+		TimeInForce:     o.TimeInForce,
+		SMPType:         o.SMPType,
+		PreventedVolume: o.PreventedVolume,
+		PreventedLocked: o.PreventedLocked,
+	}, true, nil
+}
+
 // CancelOrder 주문 취소 접수
 func (c *Client) CancelOrder(ctx context.Context, req CancelOrderRequest) (Order, error) {
 	path := "/order"
@@ -3,6 +3,7 @@ package private
 import (
 	"context"
 	"github.com/stretchr/testify/assert"
+	"github.com/wooobo/go-upbit-client/pkg/decimal"
 	"testing"
 	"time"
 )
@@ -50,8 +51,8 @@ func TestClient_PlaceOrder(t *testing.T) {
 			req: PlaceOrderRequest{
 				Market:      "KRW-BTC",
 				Side:        OrderSideBid,
-				Volume:      "0.0001",
-				Price:       "80000000",
+				Volume:      decimal.MustParse("0.0001"),
+				Price:       decimal.MustParse("80000000"),
 				OrdType:     "limit",
 				Identifier:  "",
 				TimeInForce: "",
@@ -62,8 +63,8 @@ func TestClient_PlaceOrder(t *testing.T) {
 			req: PlaceOrderRequest{
 				Market:      "KRW-BTC",
 				Side:        OrderSideAsk,
-				Volume:      "0.0001",
-				Price:       "99000000",
+				Volume:      decimal.MustParse("0.0001"),
+				Price:       decimal.MustParse("99000000"),
 				OrdType:     "limit",
 				Identifier:  "",
 				TimeInForce: "",
@@ -92,8 +93,8 @@ func TestClient_FetchCancelOrder(t *testing.T) {
 	placeOrder := PlaceOrderRequest{
 		Market:      "KRW-BTC",
 		Side:        OrderSideBid,
-		Volume:      "0.0001",
-		Price:       "80000000",
+		Volume:      decimal.MustParse("0.0001"),
+		Price:       decimal.MustParse("80000000"),
 		OrdType:     "limit",
 		Identifier:  "",
 		TimeInForce: "",
@@ -125,8 +126,8 @@ func TestClient_GetFilledOrder(t *testing.T) {
 	placeOrder := PlaceOrderRequest{
 		Market:      "KRW-BTC",
 		Side:        OrderSideBid,
-		Volume:      "0.0001",
-		Price:       "80000000",
+		Volume:      decimal.MustParse("0.0001"),
+		Price:       decimal.MustParse("80000000"),
 		OrdType:     "limit",
 		Identifier:  "",
 		TimeInForce: "",
@@ -155,8 +156,8 @@ func TestClient_GetOrdersByIdentifier(t *testing.T) {
 	placeOrder := PlaceOrderRequest{
 		Market:  "KRW-BTC",
 		Side:    OrderSideBid,
-		Volume:  "0.0001",
-		Price:   "80000000",
+		Volume:  decimal.MustParse("0.0001"),
+		Price:   decimal.MustParse("80000000"),
 		OrdType: "limit",
 		//Identifier:  "test",
 		TimeInForce: "",
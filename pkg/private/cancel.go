@@ -0,0 +1,158 @@
+package private
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/wooobo/go-upbit-client/pkg/market"
+)
+
+const (
+	maxCancelPairs = 20  // 일괄 취소 pairs, excluded_pairs 최대 개수
+	maxCancelCount = 300 // 일괄 취소 한 번에 취소할 수 있는 최대 주문 수
+	maxCancelIDs   = 20  // id 로 취소할 수 있는 최대 주문 수
+)
+
+// CancelSide 일괄 취소할 주문 방향
+type CancelSide string
+
+func (s CancelSide) String() string { return string(s) }
+
+const (
+	CancelSideAll CancelSide = "all" // 매수, 매도 모두
+	CancelSideAsk CancelSide = "ask" // 매도
+	CancelSideBid CancelSide = "bid" // 매수
+)
+
+// CancelOpenOrdersRequest 체결 대기 주문 일괄 취소 조건. 비어 있는 조건은 적용하지 않는다.
+type CancelOpenOrdersRequest struct {
+	CancelSide      CancelSide             // 취소할 주문 방향 (default: all)
+	Pairs           []MarketCode           // 취소할 마켓 (최대 20개), QuoteCurrencies 와 함께 사용할 수 없음
+	ExcludedPairs   []MarketCode           // 취소에서 제외할 마켓 (최대 20개)
+	QuoteCurrencies []market.QuoteCurrency // 취소할 기준 화폐 마켓
+	Count           int                    // 취소할 최대 주문 수, 0 이면 서버 기본값 20 (최대 300)
+	OrderBy         OrderBy                // 주문 생성 시각 기준 취소 순서 (default: desc)
+}
+
+// CancelOrdersRequest id 로 주문 일괄 취소. UUIDs, Identifiers 중 하나만 사용한다. (최대 20개)
+type CancelOrdersRequest struct {
+	UUIDs       []string
+	Identifiers []string
+}
+
+// CancelledOrder 일괄 취소 결과의 주문
+type CancelledOrder struct {
+	UUID       string `json:"uuid"`
+	Market     string `json:"market"`
+	Identifier string `json:"identifier"`
+}
+
+// CancelResultGroup 일괄 취소 성공 또는 실패한 주문 목록
+type CancelResultGroup struct {
+	Count  int              `json:"count"`
+	Orders []CancelledOrder `json:"orders"`
+}
+
+// BatchCancelResult 일괄 취소 결과
+type BatchCancelResult struct {
+	Success CancelResultGroup `json:"success"` // 취소 접수된 주문
+	Failed  CancelResultGroup `json:"failed"`  // 취소에 실패한 주문
+}
+
+func joinMarkets(codes []MarketCode) (string, error) {
+	values, err := market.Strings(codes)
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(values, ","), nil
+}
+
+// CancelOpenOrders 조건에 맞는 체결 대기 주문을 일괄 취소 접수한다.
+// 조건을 모두 비우면 모든 마켓의 체결 대기 주문을 최근 주문부터 Count 개 취소한다.
+// 한 번에 최대 300개까지 취소하므로 그보다 많으면 Failed 가 없고 Success.Count 가 Count 와 같은 동안 반복 호출한다.
+func (c *Client) CancelOpenOrders(ctx context.Context, req CancelOpenOrdersRequest) (BatchCancelResult, error) {
+	if len(req.Pairs) > 0 && len(req.QuoteCurrencies) > 0 {
+		return BatchCancelResult{}, fmt.Errorf("%w: pairs and quote_currencies can not be used together", ErrInvalidOrder)
+	}
+	if len(req.Pairs) > maxCancelPairs || len(req.ExcludedPairs) > maxCancelPairs {
+		return BatchCancelResult{}, fmt.Errorf("%w: pairs and excluded_pairs allow at most %d markets", ErrInvalidOrder, maxCancelPairs)
+	}
+	if req.Count < 0 || req.Count > maxCancelCount {
+		return BatchCancelResult{}, fmt.Errorf("%w: count must be between 0 and %d (0 = server default)", ErrInvalidOrder, maxCancelCount)
+	}
+	switch req.CancelSide {
+	case "", CancelSideAll, CancelSideAsk, CancelSideBid:
+	default:
+		return BatchCancelResult{}, fmt.Errorf("%w: unknown cancel_side %q", ErrInvalidOrder, req.CancelSide)
+	}
+
+	path := "/orders/open"
+	values := url.Values{}
+	if req.CancelSide != "" {
+		values.Set("cancel_side", req.CancelSide.String())
+	}
+	if len(req.Pairs) > 0 {
+		pairs, err := joinMarkets(req.Pairs)
+		if err != nil {
+			return BatchCancelResult{}, err
+		}
+		values.Set("pairs", pairs)
+	}
+	if len(req.ExcludedPairs) > 0 {
+		pairs, err := joinMarkets(req.ExcludedPairs)
+		if err != nil {
+			return BatchCancelResult{}, err
+		}
+		values.Set("excluded_pairs", pairs)
+	}
+	if len(req.QuoteCurrencies) > 0 {
+		quotes := make([]string, len(req.QuoteCurrencies))
+		for i, quote := range req.QuoteCurrencies {
+			if !quote.Valid() {
+				return BatchCancelResult{}, fmt.Errorf("%w: unsupported quote currency %q", ErrInvalidOrder, quote)
+			}
+			quotes[i] = string(quote)
+		}
+		values.Set("quote_currencies", strings.Join(quotes, ","))
+	}
+	if req.Count > 0 {
+		values.Set("count", strconv.Itoa(req.Count))
+	}
+	if req.OrderBy != "" {
+		values.Set("order_by", req.OrderBy.String())
+	}
+
+	var resp BatchCancelResult
+	if err := c.Delete(ctx, path, values, &resp); err != nil {
+		return BatchCancelResult{}, fmt.Errorf("failed to cancel open orders: %w", err)
+	}
+	return resp, nil
+}
+
+// CancelOrders UUID 또는 사용자 지정값 목록으로 주문을 일괄 취소 접수한다. 주문별 결과는 Success, Failed 로 나뉜다.
+func (c *Client) CancelOrders(ctx context.Context, req CancelOrdersRequest) (BatchCancelResult, error) {
+	if (len(req.UUIDs) == 0) == (len(req.Identifiers) == 0) {
+		return BatchCancelResult{}, fmt.Errorf("%w: exactly one of uuids or identifiers is required", ErrInvalidOrder)
+	}
+	if len(req.UUIDs) > maxCancelIDs || len(req.Identifiers) > maxCancelIDs {
+		return BatchCancelResult{}, fmt.Errorf("%w: at most %d orders can be cancelled at once", ErrInvalidOrder, maxCancelIDs)
+	}
+
+	path := "/orders/uuids"
+	values := url.Values{}
+	for _, uuid := range req.UUIDs {
+		values.Add("uuids[]", uuid)
+	}
+	for _, identifier := range req.Identifiers {
+		values.Add("identifiers[]", identifier)
+	}
+
+	var resp BatchCancelResult
+	if err := c.Delete(ctx, path, values, &resp); err != nil {
+		return BatchCancelResult{}, fmt.Errorf("failed to cancel orders: %w", err)
+	}
+	return resp, nil
+}
@@ -2,13 +2,37 @@ package private
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
-	"strings"
 	"time"
+
+	"github.com/wooobo/go-upbit-client/internal/transport"
+	"github.com/wooobo/go-upbit-client/pkg/apierror"
+	"github.com/wooobo/go-upbit-client/pkg/ratelimit"
+	"github.com/wooobo/go-upbit-client/pkg/retry"
+)
+
+// APIError 업비트 API 에러 응답. errors.As 또는 apierror.As 로 꺼낼 수 있다.
+type APIError = apierror.Error
+
+// Option NewClient 에 전달하는 클라이언트 옵션
+type Option = transport.Option
+
+// Interceptor 모든 요청 전후에 실행되는 훅 (BeforeSend, AfterReceive, OnError)
+type Interceptor = transport.Interceptor
+
+// Call 인터셉터에 전달되는 요청 경로, 쿼리, 상태 코드, 소요 시간, 에러 정보
+type Call = transport.Call
+
+var (
+	WithHTTPClient   = transport.WithHTTPClient   // 요청에 사용할 *http.Client
+	WithBaseURL      = transport.WithBaseURL      // 버전을 포함한 API 기본 URL (Config.BaseUrl, Config.Version 보다 우선)
+	WithUserAgent    = transport.WithUserAgent    // User-Agent 헤더
+	WithTimeout      = transport.WithTimeout      // 요청 타임아웃 (default: 10s)
+	WithRoundTripper = transport.WithRoundTripper // http.RoundTripper 미들웨어
+	WithInterceptors = transport.WithInterceptors // 요청 전후 인터셉터
+	WithLogger       = transport.WithLogger       // log/slog 요청 로그 (인증 정보는 기록하지 않음)
 )
 
 type Config struct {
@@ -16,85 +40,74 @@ type Config struct {
 	SecretApiKey string
 	BaseUrl      string
 	Version      string
+	Limiter      *ratelimit.Limiter // 클라이언트 측 요청 수 제한, nil 이면 제한하지 않음
+	Retry        *retry.Policy      // GET 요청 재시도 정책, nil 이면 retry.DefaultPolicy
+
+	ValidateTickSize bool          // 지정가 주문 전에 가격이 호가 단위에 맞는지 확인 (ticksize.Validate)
+	PreTradeCheck    bool          // 주문 전에 캐시된 주문 가능 정보로 주문 타입, 주문 금액, 잔고를 확인 (Client.CheckOrder)
+	OrderChanceTTL   time.Duration // PreTradeCheck 에 사용하는 주문 가능 정보 캐시 유지 시간 (default: 5s)
+}
+
+// LogValue 로그에 API 키가 남지 않도록 가린다.
+func (c Config) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("base_url", c.BaseUrl),
+		slog.String("version", c.Version),
+		slog.String("public_api_key", redacted),
+		slog.String("secret_api_key", redacted),
+	)
 }
 
 type Client struct {
-	baseURL    string
-	jwtManager *JWTManager
-	httpClient *http.Client
+	jwtManager       *JWTManager
+	transport        *transport.Transport
+	validateTickSize bool
+	preTradeCheck    bool
+	chances          *chanceCache
 }
 
-func NewClient(client Config) *Client {
-	return &Client{
-		baseURL:    client.BaseUrl + client.Version,
-		jwtManager: NewJWT(client.PublicApiKey, client.SecretApiKey),
-		httpClient: &http.Client{
-			Timeout: 10 * time.Second,
-		},
+func NewClient(client Config, opts ...Option) *Client {
+	c := &Client{
+		jwtManager:       NewJWT(client.PublicApiKey, client.SecretApiKey),
+		validateTickSize: client.ValidateTickSize,
+		preTradeCheck:    client.PreTradeCheck,
+		chances:          newChanceCache(client.OrderChanceTTL),
+	}
+
+	base := []Option{
+		transport.WithLimiter(client.Limiter),
+		transport.WithAuthorizer(c.authorize),
 	}
+	if client.Retry != nil {
+		base = append(base, transport.WithRetry(*client.Retry))
+	}
+	c.transport = transport.New(client.BaseUrl+client.Version, append(base, opts...)...)
+
+	return c
 }
 
 func (c *Client) Get(ctx context.Context, path string, body url.Values, v interface{}) error {
-	return c.do(ctx, http.MethodGet, path, body, nil, v)
+	return c.transport.Do(ctx, transport.Request{Method: http.MethodGet, Path: path, Query: body}, v)
 }
 
 func (c *Client) Post(ctx context.Context, path string, body url.Values, v interface{}) error {
-	return c.do(ctx, http.MethodPost, path, nil, body, v)
+	return c.transport.Do(ctx, transport.Request{Method: http.MethodPost, Path: path, Form: body}, v)
 }
 
 func (c *Client) Delete(ctx context.Context, path string, body url.Values, v interface{}) error {
-	return c.do(ctx, http.MethodDelete, path, nil, body, v)
+	return c.transport.Do(ctx, transport.Request{Method: http.MethodDelete, Path: path, Form: body}, v)
 }
 
-func (c *Client) do(ctx context.Context, method, path string, params url.Values, body url.Values, v interface{}) error {
-	u, err := url.Parse(c.baseURL + path)
-	if err != nil {
-		return fmt.Errorf("parsing URL: %w", err)
-	}
-
-	if params != nil {
-		u.RawQuery = params.Encode()
-	}
-
-	var bodyReader io.Reader
-	if body != nil {
-		bodyReader = strings.NewReader(body.Encode())
-	}
-
-	urlPath := u.String()
-	req, err := http.NewRequestWithContext(ctx, method, urlPath, bodyReader)
-	if err != nil {
-		return fmt.Errorf("creating request: %w", err)
-	}
-
-	if method == http.MethodPost || method == http.MethodDelete {
-		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-		req.Header.Add("Authorization", c.jwtManager.CreateTokenWithQuery(body))
-	} else {
-		req.Header.Set("Content-Type", "application/json")
-		req.Header.Add("Authorization", c.jwtManager.CreateTokenWithQuery(params))
-	}
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("sending request: %w", err)
-	}
-	defer func() {
-		if err := resp.Body.Close(); err != nil {
-			err = fmt.Errorf("closing response body: %w", err)
-		}
-	}()
-
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode, string(body))
-	}
+// RemainingReq 그룹의 가장 최근 잔여 요청 수 (Remaining-Req 헤더)
+func (c *Client) RemainingReq(group ratelimit.Group) (ratelimit.Remaining, bool) {
+	return c.transport.RemainingReq(group)
+}
 
-	if v != nil {
-		if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
-			return fmt.Errorf("decoding response body: %w", err)
-		}
-	}
+// RemainingReqs 모든 그룹의 가장 최근 잔여 요청 수
+func (c *Client) RemainingReqs() map[ratelimit.Group]ratelimit.Remaining {
+	return c.transport.RemainingReqs()
+}
 
-	return nil
+func (c *Client) authorize(req *http.Request, payload url.Values) {
+	req.Header.Set("Authorization", c.jwtManager.CreateTokenWithQuery(payload))
 }
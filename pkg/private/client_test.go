@@ -0,0 +1,758 @@
+package private
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wooobo/go-upbit-client/pkg/apierror"
+	"github.com/wooobo/go-upbit-client/pkg/decimal"
+	"github.com/wooobo/go-upbit-client/pkg/market"
+	"github.com/wooobo/go-upbit-client/pkg/retry"
+	"github.com/wooobo/go-upbit-client/pkg/ticksize"
+)
+
+func testServerClient(t *testing.T, handler http.HandlerFunc) *Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return NewClient(Config{
+		BaseUrl:      server.URL,
+		Version:      testVer,
+		PublicApiKey: "access",
+		SecretApiKey: "secret",
+		Retry:        &retry.Policy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond},
+	})
+}
+
+func TestClient_PlaceOrder_RetryWithIdentifier(t *testing.T) {
+	var posts atomic.Int32
+	client := testServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/orders":
+			posts.Add(1)
+			w.WriteHeader(http.StatusBadGateway)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/orders/uuids":
+			assert.Equal(t, "bot-1", r.URL.Query().Get("identifiers[]"))
+			_, _ = w.Write([]byte(`[{"uuid":"placed-uuid","side":"bid","ord_type":"limit","price":"80000000","market":"KRW-BTC"}]`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	actual, err := client.PlaceOrder(context.Background(), PlaceOrderRequest{
+		Market:     "KRW-BTC",
+		Side:       OrderSideBid,
+		Volume:     decimal.MustParse("0.0001"),
+		Price:      decimal.MustParse("80000000"),
+		OrdType:    "limit",
+		Identifier: "bot-1",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "placed-uuid", actual.UUID)
+	assert.Equal(t, int32(1), posts.Load())
+}
+
+func TestClient_PlaceOrder_NoRetryWithoutIdentifier(t *testing.T) {
+	var posts atomic.Int32
+	client := testServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		posts.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	_, err := client.PlaceOrder(context.Background(), PlaceOrderRequest{
+		Market:  "KRW-BTC",
+		Side:    OrderSideBid,
+		Volume:  decimal.MustParse("0.0001"),
+		Price:   decimal.MustParse("80000000"),
+		OrdType: "limit",
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, int32(1), posts.Load())
+}
+
+func TestClient_Get_Retry(t *testing.T) {
+	var calls atomic.Int32
+	client := testServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Remaining-Req", "group=default; min=1799; sec=0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`[{"currency":"KRW","balance":"1000"}]`))
+	})
+
+	actual, err := client.GetAccounts(context.Background())
+
+	assert.NoError(t, err)
+	assert.Len(t, actual, 1)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestClient_WithLogger_Redaction(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NotEmpty(t, r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"error":{"name":"jwt_verification","message":"Jwt 토큰 검증에 실패했습니다."}}`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	config := Config{
+		BaseUrl:      server.URL,
+		Version:      testVer,
+		PublicApiKey: "my-access-key",
+		SecretApiKey: "my-secret-key",
+		Retry:        &retry.NoRetry,
+	}
+	client := NewClient(config, WithLogger(logger))
+
+	_, err := client.GetOrderChance(context.Background(), "KRW-BTC")
+	logger.Info("config", slog.Any("config", config), slog.Any("jwt", client.jwtManager))
+
+	assert.Error(t, err)
+	out := buf.String()
+	assert.Contains(t, out, "upbit request failed")
+	assert.Contains(t, out, "/orders/chance")
+	assert.NotContains(t, out, "my-access-key")
+	assert.NotContains(t, out, "my-secret-key")
+	assert.NotContains(t, out, "Bearer")
+}
+
+func TestClient_WithLogger_WithdrawAddress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":{"name":"withdraw_address_not_registered","message":"출금 허용 주소가 아닙니다."}}`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client := NewClient(Config{BaseUrl: server.URL, Version: testVer, Retry: &retry.NoRetry}, WithLogger(logger))
+
+	_, err := client.Withdraw(context.Background(), WithdrawRequest{
+		Currency: "XRP", NetType: "XRP", Amount: decimal.NewFromInt(50), Address: "rSecretAddress", SecondaryAddress: "98765",
+	})
+
+	assert.Error(t, err)
+	out := buf.String()
+	assert.Contains(t, out, "/withdraws/coin")
+	assert.Contains(t, out, "REDACTED")
+	assert.NotContains(t, out, "rSecretAddress")
+	assert.NotContains(t, out, "98765")
+}
+
+func TestClient_PlaceOrder_DecimalForm(t *testing.T) {
+	client := testServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "0.3", r.PostForm.Get("volume"))
+		assert.Equal(t, "80000000", r.PostForm.Get("price"))
+		_, _ = w.Write([]byte(`{"uuid":"1","price":"80000000.0","volume":"0.3","locked":"24012000.0"}`))
+	})
+
+	actual, err := client.PlaceOrder(context.Background(), PlaceOrderRequest{
+		Market:  "KRW-BTC",
+		Side:    OrderSideBid,
+		Volume:  decimal.MustParse("0.1").Add(decimal.MustParse("0.2")),
+		Price:   decimal.NewFromInt(80000000),
+		OrdType: "limit",
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, actual.Locked.Equal(decimal.MustParse("24012000")))
+	assert.Equal(t, "24000000", actual.Price.Mul(actual.Volume).String())
+}
+
+func TestClient_PlaceOrder_InvalidMarket(t *testing.T) {
+	client := testServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s", r.URL.Path)
+	})
+
+	_, err := client.PlaceOrder(context.Background(), PlaceOrderRequest{
+		Market:  "KRW-BTC,KRW-ETH",
+		Side:    OrderSideBid,
+		Volume:  decimal.MustParse("0.1"),
+		Price:   decimal.NewFromInt(80000000),
+		OrdType: "limit",
+	})
+	assert.ErrorIs(t, err, market.ErrInvalidCode)
+
+	_, err = client.GetOrderChance(context.Background(), "btc")
+	assert.ErrorIs(t, err, market.ErrInvalidCode)
+}
+
+func TestClient_PlaceOrder_ValidateTickSize(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		_, _ = w.Write([]byte(`{"uuid":"1"}`))
+	}))
+	t.Cleanup(server.Close)
+	client := NewClient(Config{BaseUrl: server.URL, Version: testVer, ValidateTickSize: true})
+
+	order := PlaceOrderRequest{
+		Market:  "KRW-BTC",
+		Side:    OrderSideBid,
+		Volume:  decimal.MustParse("0.01"),
+		Price:   decimal.MustParse("80000400"),
+		OrdType: "limit",
+	}
+	_, err := client.PlaceOrder(context.Background(), order)
+	assert.ErrorIs(t, err, ticksize.ErrInvalidTick)
+	assert.Equal(t, int32(0), requests.Load())
+
+	order.Price, err = ticksize.RoundDown(order.Market, order.Price)
+	assert.NoError(t, err)
+	_, err = client.PlaceOrder(context.Background(), order)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), requests.Load())
+}
+
+func TestClient_PlaceOrder_PreTradeCheck(t *testing.T) {
+	var chances, orders atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/orders/chance":
+			chances.Add(1)
+			_, _ = w.Write([]byte(`{
+				"bid_fee":"0.0005","ask_fee":"0.0005",
+				"market":{"id":"KRW-BTC","order_sides":["ask","bid"],
+					"bid":{"currency":"KRW","min_total":"5000"},"ask":{"currency":"BTC","min_total":"5000"},
+					"max_total":"1000000000","state":"active"},
+				"bid_types":["limit","price","limit_ioc"],"ask_types":["limit","market"],
+				"bid_account":{"currency":"KRW","balance":"1000000","locked":"0"},
+				"ask_account":{"currency":"BTC","balance":"0.01","locked":"0"}}`))
+		case "/v1/orders":
+			orders.Add(1)
+			_, _ = w.Write([]byte(`{"uuid":"1"}`))
+		default:
+			t.Errorf("unexpected request: %s", r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+	client := NewClient(Config{BaseUrl: server.URL, Version: testVer, PreTradeCheck: true})
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		order PlaceOrderRequest
+		want  error
+	}{
+		{
+			name:  "order type not allowed",
+			order: PlaceOrderRequest{Market: "KRW-BTC", Side: OrderSideAsk, Price: decimal.NewFromInt(80000000), Volume: decimal.MustParse("0.001"), OrdType: "limit", TimeInForce: "fok"},
+			want:  ErrOrderTypeNotAllowed,
+		},
+		{
+			name:  "under min total",
+			order: PlaceOrderRequest{Market: "KRW-BTC", Side: OrderSideBid, Price: decimal.NewFromInt(4000), OrdType: "price"},
+			want:  ErrUnderMinTotal,
+		},
+		{
+			name:  "insufficient bid balance including fee",
+			order: PlaceOrderRequest{Market: "KRW-BTC", Side: OrderSideBid, Price: decimal.NewFromInt(1000000), OrdType: "price"},
+			want:  ErrInsufficientBalance,
+		},
+		{
+			name:  "insufficient ask balance",
+			order: PlaceOrderRequest{Market: "KRW-BTC", Side: OrderSideAsk, Volume: decimal.MustParse("0.02"), OrdType: "market"},
+			want:  ErrInsufficientBalance,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.PlaceOrder(ctx, tt.order)
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+	assert.Equal(t, int32(1), chances.Load(), "order chance is cached")
+	assert.Equal(t, int32(0), orders.Load())
+
+	_, err := client.PlaceOrder(ctx, PlaceOrderRequest{Market: "KRW-BTC", Side: OrderSideBid, Price: decimal.NewFromInt(999000), OrdType: "price"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), orders.Load())
+
+	assert.NoError(t, client.CheckOrder(ctx, PlaceOrderRequest{Market: "KRW-BTC", Side: OrderSideAsk, Volume: decimal.MustParse("0.01"), OrdType: "market"}))
+	assert.Equal(t, int32(2), chances.Load(), "order chance is refreshed after placing an order")
+}
+
+func TestPlaceOrderRequest_Validate(t *testing.T) {
+	amount := decimal.NewFromInt(10000)
+	price := decimal.NewFromInt(80000000)
+	volume := decimal.MustParse("0.001")
+
+	valid := []PlaceOrderRequest{
+		LimitBuy("KRW-BTC", price, volume),
+		LimitSell("KRW-BTC", price, volume).WithTimeInForce(TimeInForceIOC),
+		MarketBuyByAmount("KRW-BTC", amount),
+		MarketSell("KRW-BTC", volume),
+		BestBid("KRW-BTC", amount, TimeInForceIOC),
+		BestAsk("KRW-BTC", volume, TimeInForceFOK).WithIdentifier("id-1"),
+	}
+	for _, order := range valid {
+		assert.NoError(t, order.Validate(), "%s %s", order.OrdType, order.Side)
+	}
+
+	invalid := []PlaceOrderRequest{
+		LimitBuy("KRW-BTC", price, decimal.Zero),
+		{Market: "KRW-BTC", Side: OrderSideBid, OrdType: OrdTypePrice, Price: amount, Volume: volume},
+		{Market: "KRW-BTC", Side: OrderSideAsk, OrdType: OrdTypePrice, Price: amount},
+		{Market: "KRW-BTC", Side: OrderSideAsk, OrdType: OrdTypeMarket, Volume: volume, Price: price},
+		MarketSell("KRW-BTC", volume).WithTimeInForce(TimeInForceIOC),
+		BestBid("KRW-BTC", amount, ""),
+		BestAsk("KRW-BTC", volume, "gtc"),
+		{Market: "KRW-BTC", Side: OrderSideBid, OrdType: "stop", Price: price, Volume: volume},
+	}
+	for _, order := range invalid {
+		assert.ErrorIs(t, order.Validate(), ErrInvalidOrder, "%s %s", order.OrdType, order.Side)
+	}
+}
+
+func TestClient_PlaceOrder_OmitsEmptyFields(t *testing.T) {
+	client := testServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "market", r.PostForm.Get("ord_type"))
+		assert.Equal(t, "0.001", r.PostForm.Get("volume"))
+		assert.False(t, r.PostForm.Has("price"))
+		assert.False(t, r.PostForm.Has("time_in_force"))
+		_, _ = w.Write([]byte(`{"uuid":"1"}`))
+	})
+
+	_, err := client.PlaceOrder(context.Background(), MarketSell("KRW-BTC", decimal.MustParse("0.001")))
+	assert.NoError(t, err)
+
+	_, err = client.PlaceOrder(context.Background(), BestBid("KRW-BTC", decimal.NewFromInt(10000), ""))
+	assert.ErrorIs(t, err, ErrInvalidOrder)
+}
+
+func TestClient_PlaceOrder_SMPAndPostOnly(t *testing.T) {
+	client := testServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "cancel_maker", r.PostForm.Get("smp_type"))
+		assert.False(t, r.PostForm.Has("time_in_force"))
+		_, _ = w.Write([]byte(`{"uuid":"1","ord_type":"limit","state":"cancel","smp_type":"cancel_maker","prevented_volume":"0.001","prevented_locked":"80040"}`))
+	})
+	price := decimal.NewFromInt(80000000)
+	volume := decimal.MustParse("0.001")
+
+	actual, err := client.PlaceOrder(context.Background(), LimitBuy("KRW-BTC", price, volume).WithSMPType(SMPCancelMaker))
+	assert.NoError(t, err)
+	assert.Equal(t, "cancel_maker", actual.SMPType)
+	assert.Equal(t, "0.001", actual.PreventedVolume.String())
+	assert.Equal(t, "80040", actual.PreventedLocked.String())
+
+	assert.NoError(t, LimitSell("KRW-BTC", price, volume).WithTimeInForce(TimeInForcePostOnly).Validate())
+	for _, order := range []PlaceOrderRequest{
+		LimitSell("KRW-BTC", price, volume).WithTimeInForce(TimeInForcePostOnly).WithSMPType(SMPReduce),
+		MarketSell("KRW-BTC", volume).WithTimeInForce(TimeInForcePostOnly),
+		BestAsk("KRW-BTC", volume, TimeInForcePostOnly),
+		LimitBuy("KRW-BTC", price, volume).WithSMPType("cancel_both"),
+	} {
+		assert.ErrorIs(t, order.Validate(), ErrInvalidOrder, "%s %s %s", order.OrdType, order.TimeInForce, order.SMPType)
+	}
+}
+
+func TestClient_ReplaceOrder(t *testing.T) {
+	client := testServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/orders/cancel_and_new":
+			assert.NoError(t, r.ParseForm())
+			assert.Equal(t, "old-uuid", r.PostForm.Get("prev_order_uuid"))
+			assert.Equal(t, "limit", r.PostForm.Get("new_ord_type"))
+			assert.Equal(t, "81000000", r.PostForm.Get("new_price"))
+			assert.Equal(t, "remain_only", r.PostForm.Get("new_volume"))
+			assert.Equal(t, "post_only", r.PostForm.Get("new_time_in_force"))
+			_, _ = w.Write([]byte(`{"uuid":"old-uuid","state":"wait","price":"80000000","remaining_volume":"0.001","new_order_uuid":"new-uuid"}`))
+		case "/v1/order":
+			assert.Equal(t, "new-uuid", r.URL.Query().Get("uuid"))
+			_, _ = w.Write([]byte(`{"uuid":"new-uuid","state":"wait","price":"81000000","volume":"0.001","trades":[]}`))
+		default:
+			t.Errorf("unexpected request: %s", r.URL.Path)
+		}
+	})
+
+	actual, err := client.ReplaceOrder(context.Background(), ReplaceOrderRequest{
+		PrevOrderUUID:  "old-uuid",
+		NewOrdType:     OrdTypeLimit,
+		NewPrice:       decimal.NewFromInt(81000000),
+		NewRemainOnly:  true,
+		NewTimeInForce: TimeInForcePostOnly,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "old-uuid", actual.Cancelled.UUID)
+	assert.Equal(t, "new-uuid", actual.New.UUID)
+	assert.Equal(t, "81000000", actual.New.Price.String())
+}
+
+func TestClient_ReplaceOrder_PartialFailure(t *testing.T) {
+	client := testServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/orders/cancel_and_new":
+			_, _ = w.Write([]byte(`{"uuid":"old-uuid","state":"wait","new_order_uuid":"new-uuid"}`))
+		case "/v1/order":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"name":"order_not_found","message":"주문을 찾지 못했습니다."}}`))
+		}
+	})
+
+	actual, err := client.ReplaceOrder(context.Background(), ReplaceOrderRequest{
+		PrevOrderIdentifier: "old-id",
+		NewOrdType:          OrdTypeMarket,
+		NewVolume:           decimal.MustParse("0.001"),
+	})
+
+	var lookupErr *NewOrderLookupError
+	if assert.ErrorAs(t, err, &lookupErr) {
+		assert.Equal(t, "new-uuid", lookupErr.NewOrderUUID)
+	}
+	var replaceErr *ReplaceOrderError
+	assert.False(t, errors.As(err, &replaceErr))
+	assert.ErrorIs(t, err, apierror.ErrOrderNotFound)
+	assert.Equal(t, "old-uuid", actual.Cancelled.UUID)
+	assert.Equal(t, "new-uuid", actual.New.UUID)
+
+	for _, req := range []ReplaceOrderRequest{
+		{NewOrdType: OrdTypeLimit, NewPrice: decimal.NewFromInt(1000), NewVolume: decimal.NewFromInt(1)},
+		{PrevOrderUUID: "a", PrevOrderIdentifier: "b", NewOrdType: OrdTypeMarket, NewVolume: decimal.NewFromInt(1)},
+		{PrevOrderUUID: "a", NewOrdType: OrdTypeLimit, NewPrice: decimal.NewFromInt(1000), NewVolume: decimal.NewFromInt(1), NewRemainOnly: true},
+		{PrevOrderUUID: "a", NewOrdType: OrdTypePrice, NewPrice: decimal.NewFromInt(1000), NewRemainOnly: true},
+		{PrevOrderUUID: "a", NewOrdType: OrdTypeBest, NewPrice: decimal.NewFromInt(1000)},
+	} {
+		_, err := client.ReplaceOrder(context.Background(), req)
+		assert.ErrorIs(t, err, ErrInvalidOrder)
+	}
+}
+
+func TestClient_ReplaceOrder_NewOrderNotPlaced(t *testing.T) {
+	client := testServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"uuid":"old-uuid","state":"wait"}`))
+	})
+
+	actual, err := client.ReplaceOrder(context.Background(), ReplaceOrderRequest{
+		PrevOrderUUID: "old-uuid",
+		NewOrdType:    OrdTypeMarket,
+		NewVolume:     decimal.MustParse("0.001"),
+	})
+
+	var replaceErr *ReplaceOrderError
+	if assert.ErrorAs(t, err, &replaceErr) {
+		assert.Equal(t, "old-uuid", replaceErr.Cancelled.UUID)
+	}
+	assert.ErrorIs(t, err, ErrNewOrderNotPlaced)
+	var lookupErr *NewOrderLookupError
+	assert.False(t, errors.As(err, &lookupErr))
+	assert.Empty(t, actual.New.UUID)
+}
+
+func readForm(t *testing.T, r *http.Request) url.Values {
+	body, err := io.ReadAll(r.Body)
+	assert.NoError(t, err)
+	values, err := url.ParseQuery(string(body))
+	assert.NoError(t, err)
+	return values
+}
+
+func TestClient_CancelOpenOrders(t *testing.T) {
+	client := testServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/v1/orders/open", r.URL.Path)
+		form := readForm(t, r)
+		assert.Equal(t, "bid", form.Get("cancel_side"))
+		assert.Equal(t, "KRW-BTC,KRW-ETH", form.Get("pairs"))
+		assert.Equal(t, "300", form.Get("count"))
+		assert.False(t, form.Has("quote_currencies"))
+		_, _ = w.Write([]byte(`{
+			"success":{"count":2,"orders":[{"uuid":"1","market":"KRW-BTC"},{"uuid":"2","market":"KRW-ETH","identifier":"q-2"}]},
+			"failed":{"count":1,"orders":[{"uuid":"3","market":"KRW-BTC"}]}}`))
+	})
+
+	actual, err := client.CancelOpenOrders(context.Background(), CancelOpenOrdersRequest{
+		CancelSide: CancelSideBid,
+		Pairs:      []MarketCode{"KRW-BTC", "KRW-ETH"},
+		Count:      300,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, actual.Success.Count)
+	assert.Equal(t, "q-2", actual.Success.Orders[1].Identifier)
+	assert.Equal(t, 1, actual.Failed.Count)
+	assert.Equal(t, "3", actual.Failed.Orders[0].UUID)
+
+	_, err = client.CancelOpenOrders(context.Background(), CancelOpenOrdersRequest{
+		Pairs:           []MarketCode{"KRW-BTC"},
+		QuoteCurrencies: []market.QuoteCurrency{market.KRW},
+	})
+	assert.ErrorIs(t, err, ErrInvalidOrder)
+	_, err = client.CancelOpenOrders(context.Background(), CancelOpenOrdersRequest{Count: 301})
+	assert.ErrorIs(t, err, ErrInvalidOrder)
+	assert.ErrorContains(t, err, "between 0 and 300")
+}
+
+func TestClient_CancelOpenOrders_DefaultCount(t *testing.T) {
+	client := testServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.False(t, readForm(t, r).Has("count"))
+		_, _ = w.Write([]byte(`{"success":{"count":0,"orders":[]},"failed":{"count":0,"orders":[]}}`))
+	})
+
+	_, err := client.CancelOpenOrders(context.Background(), CancelOpenOrdersRequest{})
+	assert.NoError(t, err)
+}
+
+func TestClient_CancelOrders(t *testing.T) {
+	client := testServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/v1/orders/uuids", r.URL.Path)
+		assert.Equal(t, []string{"q-1", "q-2"}, readForm(t, r)["identifiers[]"])
+		_, _ = w.Write([]byte(`{"success":{"count":1,"orders":[{"uuid":"1","identifier":"q-1"}]},"failed":{"count":1,"orders":[{"uuid":"2","identifier":"q-2"}]}}`))
+	})
+
+	actual, err := client.CancelOrders(context.Background(), CancelOrdersRequest{Identifiers: []string{"q-1", "q-2"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, actual.Success.Count)
+	assert.Equal(t, "q-2", actual.Failed.Orders[0].Identifier)
+
+	_, err = client.CancelOrders(context.Background(), CancelOrdersRequest{})
+	assert.ErrorIs(t, err, ErrInvalidOrder)
+	_, err = client.CancelOrders(context.Background(), CancelOrdersRequest{UUIDs: []string{"1"}, Identifiers: []string{"q-1"}})
+	assert.ErrorIs(t, err, ErrInvalidOrder)
+}
+
+func TestClient_Withdraw(t *testing.T) {
+	client := testServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /v1/withdraws/chance":
+			assert.Equal(t, "XRP", r.URL.Query().Get("currency"))
+			assert.Equal(t, "XRP", r.URL.Query().Get("net_type"))
+			_, _ = w.Write([]byte(`{
+				"member_level":{"security_level":4,"two_factor_auth_verified":true},
+				"currency":{"code":"XRP","withdraw_fee":"1","is_coin":true,"wallet_state":"working","wallet_support":["deposit","withdraw"]},
+				"account":{"currency":"XRP","balance":"100.5","locked":"0"},
+				"withdraw_limit":{"currency":"XRP","minimum":"21","onetime":null,"daily":"100000","remaining_daily":"99000","fixed":6,"can_withdraw":true}}`))
+		case "POST /v1/withdraws/coin":
+			assert.NoError(t, r.ParseForm())
+			assert.Equal(t, "50", r.PostForm.Get("amount"))
+			assert.Equal(t, "rAddress", r.PostForm.Get("address"))
+			assert.Equal(t, "12345", r.PostForm.Get("secondary_address"))
+			assert.False(t, r.PostForm.Has("transaction_type"))
+			_, _ = w.Write([]byte(`{"type":"withdraw","uuid":"w-1","currency":"XRP","net_type":"XRP","state":"WAITING","created_at":"2024-09-19T10:00:00+09:00","done_at":null,"amount":"50","fee":"1","transaction_type":"default"}`))
+		case "DELETE /v1/withdraws/coin":
+			assert.Equal(t, "w-1", readForm(t, r).Get("uuid"))
+			_, _ = w.Write([]byte(`{"uuid":"w-1","state":"CANCELED","amount":"50"}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+	ctx := context.Background()
+
+	chance, err := client.GetWithdrawChance(ctx, "XRP", "XRP")
+	assert.NoError(t, err)
+	assert.True(t, chance.WithdrawLimit.CanWithdraw)
+	assert.Equal(t, "21", chance.WithdrawLimit.Minimum.String())
+	assert.True(t, chance.WithdrawLimit.Onetime.IsZero())
+	assert.Equal(t, "100.5", chance.Account.Balance.String())
+
+	withdraw, err := client.Withdraw(ctx, WithdrawRequest{
+		Currency: "XRP", NetType: "XRP", Amount: decimal.NewFromInt(50), Address: "rAddress", SecondaryAddress: "12345",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, WithdrawStateWaiting, withdraw.State)
+	assert.Nil(t, withdraw.DoneAt)
+	assert.Equal(t, time.Date(2024, 9, 19, 1, 0, 0, 0, time.UTC), withdraw.CreatedAt.UTC())
+
+	canceled, err := client.CancelWithdraw(ctx, withdraw.UUID)
+	assert.NoError(t, err)
+	assert.Equal(t, WithdrawStateCanceled, canceled.State)
+
+	_, err = client.Withdraw(ctx, WithdrawRequest{Currency: "XRP", NetType: "XRP", Address: "rAddress"})
+	assert.ErrorIs(t, err, ErrInvalidWithdraw)
+	_, err = client.GetWithdraw(ctx, WithdrawQuery{Currency: "XRP"})
+	assert.ErrorIs(t, err, ErrInvalidWithdraw)
+}
+
+func TestClient_AllWithdraws(t *testing.T) {
+	var cursors []string
+	client := testServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/withdraws", r.URL.Path)
+		assert.Equal(t, "DONE", r.URL.Query().Get("state"))
+		assert.Equal(t, "2", r.URL.Query().Get("limit"))
+		to := r.URL.Query().Get("to")
+		cursors = append(cursors, to)
+		switch to {
+		case "":
+			_, _ = w.Write([]byte(`[{"uuid":"w-5"},{"uuid":"w-4"}]`))
+		case "w-4":
+			// 커서 항목이 다시 포함되는 경우
+			_, _ = w.Write([]byte(`[{"uuid":"w-4"},{"uuid":"w-3"}]`))
+		case "w-3":
+			_, _ = w.Write([]byte(`[{"uuid":"w-2"}]`))
+		}
+	})
+
+	var uuids []string
+	for withdraw, err := range client.AllWithdraws(context.Background(), ListWithdrawsRequest{State: WithdrawStateDone, Limit: 2}) {
+		assert.NoError(t, err)
+		uuids = append(uuids, withdraw.UUID)
+	}
+
+	assert.Equal(t, []string{"w-5", "w-4", "w-3", "w-2"}, uuids)
+	assert.Equal(t, []string{"", "w-4", "w-3"}, cursors)
+}
+
+func TestClient_Deposits(t *testing.T) {
+	client := testServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/deposits":
+			assert.Equal(t, []string{"tx-1", "tx-2"}, r.URL.Query()["txids[]"])
+			_, _ = w.Write([]byte(`[
+				{"type":"deposit","uuid":"d-1","currency":"BTC","net_type":"BTC","txid":"tx-1","state":"ACCEPTED","created_at":"2024-09-19T10:00:00+09:00","done_at":"2024-09-19T10:30:00+09:00","amount":"0.5","fee":"0","transaction_type":"default"},
+				{"type":"deposit","uuid":"d-2","currency":"BTC","net_type":"BTC","txid":"tx-2","state":"TRAVEL_RULE_SUSPECTED","created_at":"2024-09-19T11:00:00+09:00","done_at":null,"amount":"1.25","fee":"0","transaction_type":"default"}]`))
+		case "/v1/deposits/chance/coin":
+			_, _ = w.Write([]byte(`{"currency":"BTC","net_type":"BTC","is_deposit_possible":true,"minimum_deposit_amount":"0.0001","minimum_deposit_confirmations":2,"decimal_precision":8}`))
+		default:
+			t.Errorf("unexpected request: %s", r.URL.Path)
+		}
+	})
+	ctx := context.Background()
+
+	deposits, err := client.ListDeposits(ctx, ListDepositsRequest{Currency: "BTC", TxIDs: []string{"tx-1", "tx-2"}})
+	assert.NoError(t, err)
+	assert.Len(t, deposits, 2)
+	assert.Equal(t, DepositStateAccepted, deposits[0].State)
+	assert.NotNil(t, deposits[0].DoneAt)
+	assert.Equal(t, DepositStateTravelRuleSuspected, deposits[1].State)
+	assert.Equal(t, "1.75", deposits[0].Amount.Add(deposits[1].Amount).String())
+
+	chance, err := client.GetDepositChance(ctx, "BTC", "BTC")
+	assert.NoError(t, err)
+	assert.True(t, chance.IsDepositPossible)
+	assert.Equal(t, 2, chance.MinimumDepositConfirmations)
+
+	_, err = client.GetDeposit(ctx, DepositQuery{})
+	assert.ErrorIs(t, err, ErrInvalidDeposit)
+}
+
+func TestClient_WaitDepositAddress(t *testing.T) {
+	var polls atomic.Int32
+	client := testServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/deposits/generate_coin_address":
+			_, _ = w.Write([]byte(`{"success":true,"message":"creating XRP deposit address."}`))
+		case "/v1/deposits/coin_address":
+			switch polls.Add(1) {
+			case 1:
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"error":{"name":"coin_address_not_found","message":"not found"}}`))
+			case 2:
+				_, _ = w.Write([]byte(`{"currency":"XRP","net_type":"XRP","deposit_address":null,"secondary_address":null}`))
+			default:
+				_, _ = w.Write([]byte(`{"currency":"XRP","net_type":"XRP","deposit_address":"rAddress","secondary_address":"12345"}`))
+			}
+		default:
+			t.Errorf("unexpected request: %s", r.URL.Path)
+		}
+	})
+	ctx := context.Background()
+
+	generated, err := client.GenerateDepositAddress(ctx, "XRP", "XRP")
+	assert.NoError(t, err)
+	assert.True(t, generated.Pending)
+	assert.Nil(t, generated.Address)
+	assert.Equal(t, "creating XRP deposit address.", generated.Message)
+
+	address, err := client.WaitDepositAddress(ctx, "XRP", "XRP", time.Millisecond)
+	assert.NoError(t, err)
+	assert.Equal(t, "rAddress", address.DepositAddress)
+	assert.Equal(t, "12345", address.SecondaryAddress)
+	assert.Equal(t, int32(3), polls.Load())
+}
+
+func TestClient_KRW(t *testing.T) {
+	client := testServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /v1/deposits/krw":
+			assert.NoError(t, r.ParseForm())
+			assert.Equal(t, "100000", r.PostForm.Get("amount"))
+			assert.Equal(t, "kakao", r.PostForm.Get("two_factor_type"))
+			_, _ = w.Write([]byte(`{"type":"deposit","uuid":"d-1","currency":"KRW","state":"PROCESSING","amount":"100000","fee":"0"}`))
+		case "POST /v1/withdraws/krw":
+			assert.NoError(t, r.ParseForm())
+			assert.Equal(t, "naver", r.PostForm.Get("two_factor_type"))
+			_, _ = w.Write([]byte(`{"type":"withdraw","uuid":"w-1","currency":"KRW","state":"PROCESSING","amount":"50000","fee":"1000"}`))
+		case "GET /v1/withdraws":
+			assert.Equal(t, "KRW", r.URL.Query().Get("currency"))
+			_, _ = w.Write([]byte(`[{"uuid":"w-1","currency":"KRW","state":"DONE","amount":"50000"}]`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+	ctx := context.Background()
+
+	deposit, err := client.DepositKRW(ctx, KRWRequest{Amount: decimal.NewFromInt(100000), TwoFactorType: TwoFactorKakao})
+	assert.NoError(t, err)
+	assert.Equal(t, DepositStateProcessing, deposit.State)
+
+	withdraw, err := client.WithdrawKRW(ctx, KRWRequest{Amount: decimal.NewFromInt(50000), TwoFactorType: TwoFactorNaver})
+	assert.NoError(t, err)
+	assert.Equal(t, "1000", withdraw.Fee.String())
+
+	withdraws, err := client.ListKRWWithdraws(ctx, ListWithdrawsRequest{Currency: "BTC"})
+	assert.NoError(t, err)
+	assert.Len(t, withdraws, 1)
+
+	_, err = client.WithdrawKRW(ctx, KRWRequest{Amount: decimal.NewFromInt(50000), TwoFactorType: "sms"})
+	assert.ErrorIs(t, err, ErrInvalidWithdraw)
+	_, err = client.DepositKRW(ctx, KRWRequest{TwoFactorType: TwoFactorHana})
+	assert.ErrorIs(t, err, ErrInvalidDeposit)
+}
+
+func TestClient_TravelRule(t *testing.T) {
+	client := testServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /v1/travel_rule/vasps":
+			_, _ = w.Write([]byte(`[{"vasp_name":"Bithumb","vasp_uuid":"v-1","depositable":true,"withdrawable":false}]`))
+		case "POST /v1/travel_rule/deposit/uuid":
+			assert.NoError(t, r.ParseForm())
+			assert.Equal(t, "d-1", r.PostForm.Get("deposit_uuid"))
+			assert.Equal(t, "v-1", r.PostForm.Get("vasp_uuid"))
+			_, _ = w.Write([]byte(`{"deposit_uuid":"d-1","verification_result":"verified","deposit_state":"ACCEPTED"}`))
+		case "POST /v1/travel_rule/deposit/txid":
+			assert.NoError(t, r.ParseForm())
+			assert.Equal(t, "tx-2", r.PostForm.Get("txid"))
+			assert.Equal(t, "ETH", r.PostForm.Get("net_type"))
+			_, _ = w.Write([]byte(`{"deposit_uuid":"d-2","verification_result":"failed","deposit_state":"TRAVEL_RULE_SUSPECTED"}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+	ctx := context.Background()
+
+	vasps, err := client.ListTravelRuleVASPs(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []VASP{{Name: "Bithumb", UUID: "v-1", Depositable: true}}, vasps)
+
+	verified, err := client.VerifyDepositByUUID(ctx, "d-1", vasps[0].UUID)
+	assert.NoError(t, err)
+	assert.True(t, verified.Verified())
+	assert.Equal(t, DepositStateAccepted, verified.DepositState)
+
+	failed, err := client.VerifyDepositByTxID(ctx, TravelRuleTxIDRequest{VASPUUID: "v-1", TxID: "tx-2", Currency: "ETH", NetType: "ETH"})
+	assert.NoError(t, err)
+	assert.False(t, failed.Verified())
+	assert.Equal(t, DepositStateTravelRuleSuspected, failed.DepositState)
+
+	_, err = client.VerifyDepositByTxID(ctx, TravelRuleTxIDRequest{VASPUUID: "v-1", TxID: "tx-2"})
+	assert.ErrorIs(t, err, ErrInvalidDeposit)
+}
@@ -0,0 +1,283 @@
+package private
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/wooobo/go-upbit-client/pkg/apierror"
+	"github.com/wooobo/go-upbit-client/pkg/decimal"
+)
+
+// DefaultDepositAddressPollInterval WaitDepositAddress 의 기본 조회 간격
+const DefaultDepositAddressPollInterval = 2 * time.Second
+
+// DepositState 입금 상태
+type DepositState string
+
+func (s DepositState) String() string { return string(s) }
+
+const (
+	DepositStateProcessing          DepositState = "PROCESSING"            // 진행 중
+	DepositStateAccepted            DepositState = "ACCEPTED"              // 완료
+	DepositStateCancelled           DepositState = "CANCELLED"             // 취소됨
+	DepositStateRejected            DepositState = "REJECTED"              // 거절됨
+	DepositStateTravelRuleSuspected DepositState = "TRAVEL_RULE_SUSPECTED" // 트래블룰 추가 인증 대기 중
+	DepositStateRefunding           DepositState = "REFUNDING"             // 반환 절차 진행 중
+	DepositStateRefunded            DepositState = "REFUNDED"              // 반환됨
+)
+
+var ErrInvalidDeposit = errors.New("invalid deposit request")
+
+// Deposit 입금 내역
+type Deposit struct {
+	Type            string          `json:"type"`             // 입출금 종류
+	UUID            string          `json:"uuid"`             // 입금의 고유 아이디
+	Currency        string          `json:"currency"`         // 화폐를 의미하는 영문 대문자 코드
+	NetType         string          `json:"net_type"`         // 입금 네트워크
+	TxID            string          `json:"txid"`             // 입금의 트랜잭션 아이디
+	State           DepositState    `json:"state"`            // 입금 상태
+	CreatedAt       time.Time       `json:"created_at"`       // 입금 생성 시간
+	DoneAt          *time.Time      `json:"done_at"`          // 입금 완료 시간, 완료 전이면 nil
+	Amount          decimal.Decimal `json:"amount"`           // 입금 수량
+	Fee             decimal.Decimal `json:"fee"`              // 입금 수수료
+	TransactionType TransactionType `json:"transaction_type"` // 입금 유형
+}
+
+// DepositChance 디지털 자산 입금 가능 정보
+type DepositChance struct {
+	Currency                    string          `json:"currency"`
+	NetType                     string          `json:"net_type"`
+	IsDepositPossible           bool            `json:"is_deposit_possible"`           // 입금 가능 여부
+	DepositImpossibleReason     string          `json:"deposit_impossible_reason"`     // 입금이 불가능한 사유
+	MinimumDepositAmount        decimal.Decimal `json:"minimum_deposit_amount"`        // 최소 입금 수량
+	MinimumDepositConfirmations int             `json:"minimum_deposit_confirmations"` // 입금 반영에 필요한 최소 컨펌 수
+	DecimalPrecision            int             `json:"decimal_precision"`             // 입금 수량 소수점 자리 수
+}
+
+// DepositAddress 입금 주소
+type DepositAddress struct {
+	Currency         string `json:"currency"`
+	NetType          string `json:"net_type"`
+	DepositAddress   string `json:"deposit_address"`   // 입금 주소, 생성 중이면 빈 값
+	SecondaryAddress string `json:"secondary_address"` // 2차 입금 주소 (ex. XRP destination tag)
+}
+
+// GenerateDepositAddressResult 입금 주소 생성 요청 결과
+// 주소가 이미 있으면 Address 를, 새로 생성 중이면 Pending 과 Message 를 채운다.
+type GenerateDepositAddressResult struct {
+	Address *DepositAddress // 발급된 입금 주소, 생성 중이면 nil
+	Pending bool            // 주소를 비동기로 생성 중 (GetDepositAddress 또는 WaitDepositAddress 로 확인)
+	Message string          // 생성 중 안내 메시지
+}
+
+// UnmarshalJSON {success, message} 형식의 생성 중 응답과 주소 응답을 구분한다.
+func (r *GenerateDepositAddressResult) UnmarshalJSON(data []byte) error {
+	var aux struct {
+		DepositAddress
+		Success bool   `json:"success"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	*r = GenerateDepositAddressResult{Message: aux.Message}
+	if aux.DepositAddress.DepositAddress != "" {
+		r.Address = &aux.DepositAddress
+		return nil
+	}
+	r.Pending = true
+	return nil
+}
+
+// DepositQuery 개별 입금 조회 조건. UUID, TxID 중 하나는 지정해야 한다.
+type DepositQuery struct {
+	UUID     string
+	TxID     string
+	Currency string
+}
+
+// ListDepositsRequest 입금 목록 조회 조건
+type ListDepositsRequest struct {
+	Currency string
+	State    DepositState
+	UUIDs    []string
+	TxIDs    []string
+	Limit    int     // 최대 100 (default: 100)
+	From     string  // 페이지네이션 커서 (UUID)
+	To       string  // 페이지네이션 커서 (UUID)
+	OrderBy  OrderBy // default: desc
+}
+
+// ListDeposits 입금 목록 조회 (한 페이지)
+func (c *Client) ListDeposits(ctx context.Context, req ListDepositsRequest) ([]Deposit, error) {
+	path := "/deposits"
+	values := url.Values{}
+	if req.Currency != "" {
+		values.Set("currency", req.Currency)
+	}
+	if req.State != "" {
+		values.Set("state", req.State.String())
+	}
+	for _, uuid := range req.UUIDs {
+		values.Add("uuids[]", uuid)
+	}
+	for _, txid := range req.TxIDs {
+		values.Add("txids[]", txid)
+	}
+	if req.Limit > 0 {
+		values.Set("limit", strconv.Itoa(req.Limit))
+	}
+	if req.From != "" {
+		values.Set("from", req.From)
+	}
+	if req.To != "" {
+		values.Set("to", req.To)
+	}
+	if req.OrderBy != "" {
+		values.Set("order_by", req.OrderBy.String())
+	}
+
+	var resp []Deposit
+	if err := c.Get(ctx, path, values, &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// AllDeposits 조건에 맞는 입금 내역을 UUID 커서로 페이지를 넘기며 끝까지 반환한다.
+// req.From, req.To 는 무시한다. 에러가 발생하면 에러를 한 번 반환하고 끝난다.
+func (c *Client) AllDeposits(ctx context.Context, req ListDepositsRequest) iter.Seq2[Deposit, error] {
+	if req.Limit <= 0 {
+		req.Limit = maxListLimit
+	}
+	return pageByUUID(ctx, req.Limit, req.OrderBy, func(d Deposit) string { return d.UUID },
+		func(ctx context.Context, from, to string) ([]Deposit, error) {
+			req.From, req.To = from, to
+			return c.ListDeposits(ctx, req)
+		})
+}
+
+// GetDeposit 개별 입금 조회
+func (c *Client) GetDeposit(ctx context.Context, query DepositQuery) (Deposit, error) {
+	if query.UUID == "" && query.TxID == "" {
+		return Deposit{}, fmt.Errorf("%w: uuid or txid is required", ErrInvalidDeposit)
+	}
+
+	path := "/deposit"
+	values := url.Values{}
+	if query.UUID != "" {
+		values.Set("uuid", query.UUID)
+	}
+	if query.TxID != "" {
+		values.Set("txid", query.TxID)
+	}
+	if query.Currency != "" {
+		values.Set("currency", query.Currency)
+	}
+
+	var resp Deposit
+	if err := c.Get(ctx, path, values, &resp); err != nil {
+		return Deposit{}, err
+	}
+	return resp, nil
+}
+
+// GetDepositChance 디지털 자산 입금 가능 정보
+func (c *Client) GetDepositChance(ctx context.Context, currency, netType string) (DepositChance, error) {
+	path := "/deposits/chance/coin"
+	values := url.Values{}
+	values.Set("currency", currency)
+	values.Set("net_type", netType)
+
+	var resp DepositChance
+	if err := c.Get(ctx, path, values, &resp); err != nil {
+		return DepositChance{}, err
+	}
+	return resp, nil
+}
+
+// GenerateDepositAddress 입금 주소 생성 요청
+// 주소는 비동기로 생성되므로 처음 요청하면 Pending 결과를 받는다. 생성이 끝날 때까지 기다리려면 WaitDepositAddress 를 사용한다.
+func (c *Client) GenerateDepositAddress(ctx context.Context, currency, netType string) (GenerateDepositAddressResult, error) {
+	if currency == "" || netType == "" {
+		return GenerateDepositAddressResult{}, fmt.Errorf("%w: currency and net_type are required", ErrInvalidDeposit)
+	}
+
+	path := "/deposits/generate_coin_address"
+	values := url.Values{}
+	values.Set("currency", currency)
+	values.Set("net_type", netType)
+
+	var resp GenerateDepositAddressResult
+	if err := c.Post(ctx, path, values, &resp); err != nil {
+		return GenerateDepositAddressResult{}, fmt.Errorf("failed to generate deposit address: %w", err)
+	}
+	return resp, nil
+}
+
+// GetDepositAddress 개별 입금 주소 조회. 생성 중인 주소는 DepositAddress 가 빈 값이다.
+func (c *Client) GetDepositAddress(ctx context.Context, currency, netType string) (DepositAddress, error) {
+	path := "/deposits/coin_address"
+	values := url.Values{}
+	values.Set("currency", currency)
+	values.Set("net_type", netType)
+
+	var resp DepositAddress
+	if err := c.Get(ctx, path, values, &resp); err != nil {
+		return DepositAddress{}, err
+	}
+	return resp, nil
+}
+
+// ListDepositAddresses 전체 입금 주소 조회
+func (c *Client) ListDepositAddresses(ctx context.Context) ([]DepositAddress, error) {
+	path := "/deposits/coin_addresses"
+
+	var resp []DepositAddress
+	if err := c.Get(ctx, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// WaitDepositAddress 입금 주소 생성을 요청하고 발급될 때까지 interval 간격으로 GetDepositAddress 를 조회한다.
+// interval 이 0 이하이면 DefaultDepositAddressPollInterval 을 사용하며, ctx 가 끝나면 ctx 에러를 반환한다.
+func (c *Client) WaitDepositAddress(ctx context.Context, currency, netType string, interval time.Duration) (DepositAddress, error) {
+	if interval <= 0 {
+		interval = DefaultDepositAddressPollInterval
+	}
+
+	generated, err := c.GenerateDepositAddress(ctx, currency, netType)
+	if err != nil {
+		return DepositAddress{}, err
+	}
+	if generated.Address != nil {
+		return *generated.Address, nil
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return DepositAddress{}, ctx.Err()
+		case <-ticker.C:
+		}
+
+		address, err := c.GetDepositAddress(ctx, currency, netType)
+		switch {
+		case err == nil && address.DepositAddress != "":
+			return address, nil
+		case err != nil && apierror.StatusCode(err) != http.StatusNotFound:
+			// 생성 중에는 주소가 없다는 404 응답을 받을 수 있으므로 그 외의 에러만 반환한다.
+			return DepositAddress{}, err
+		}
+	}
+}
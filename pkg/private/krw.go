@@ -0,0 +1,99 @@
+package private
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/url"
+
+	"github.com/wooobo/go-upbit-client/pkg/decimal"
+	"github.com/wooobo/go-upbit-client/pkg/market"
+)
+
+// TwoFactorType 원화 입출금 2차 인증 수단
+type TwoFactorType string
+
+func (t TwoFactorType) String() string { return string(t) }
+
+const (
+	TwoFactorKakao TwoFactorType = "kakao" // 카카오 인증
+	TwoFactorNaver TwoFactorType = "naver" // 네이버 인증
+	TwoFactorHana  TwoFactorType = "hana"  // 하나 인증서
+)
+
+func (t TwoFactorType) valid() bool {
+	return t == TwoFactorKakao || t == TwoFactorNaver || t == TwoFactorHana
+}
+
+// KRWRequest 원화 입금 또는 출금 요청
+type KRWRequest struct {
+	Amount        decimal.Decimal // 입출금 금액 (원)
+	TwoFactorType TwoFactorType   // 2차 인증 수단
+}
+
+func (r KRWRequest) values() (url.Values, error) {
+	if !r.Amount.IsPositive() {
+		return nil, fmt.Errorf("amount must be positive, got %s", r.Amount)
+	}
+	if !r.TwoFactorType.valid() {
+		return nil, fmt.Errorf("unknown two_factor_type %q", r.TwoFactorType)
+	}
+	values := url.Values{}
+	values.Set("amount", r.Amount.String())
+	values.Set("two_factor_type", r.TwoFactorType.String())
+	return values, nil
+}
+
+// DepositKRW 원화 입금하기
+// 연결된 은행 계좌에서 입금을 요청하며, 선택한 2차 인증을 마쳐야 입금이 진행된다. 요청은 재시도하지 않는다.
+func (c *Client) DepositKRW(ctx context.Context, req KRWRequest) (Deposit, error) {
+	values, err := req.values()
+	if err != nil {
+		return Deposit{}, fmt.Errorf("%w: %w", ErrInvalidDeposit, err)
+	}
+
+	var resp Deposit
+	if err := c.Post(ctx, "/deposits/krw", values, &resp); err != nil {
+		return Deposit{}, fmt.Errorf("failed to deposit KRW: %w", err)
+	}
+	return resp, nil
+}
+
+// WithdrawKRW 원화 출금하기
+// 연결된 은행 계좌로 출금을 요청하며, 선택한 2차 인증을 마쳐야 출금이 진행된다. 요청은 재시도하지 않는다.
+func (c *Client) WithdrawKRW(ctx context.Context, req KRWRequest) (Withdraw, error) {
+	values, err := req.values()
+	if err != nil {
+		return Withdraw{}, fmt.Errorf("%w: %w", ErrInvalidWithdraw, err)
+	}
+
+	var resp Withdraw
+	if err := c.Post(ctx, "/withdraws/krw", values, &resp); err != nil {
+		return Withdraw{}, fmt.Errorf("failed to withdraw KRW: %w", err)
+	}
+	return resp, nil
+}
+
+// ListKRWDeposits 원화 입금 목록 조회 (한 페이지), req.Currency 는 KRW 로 바뀐다.
+func (c *Client) ListKRWDeposits(ctx context.Context, req ListDepositsRequest) ([]Deposit, error) {
+	req.Currency = string(market.KRW)
+	return c.ListDeposits(ctx, req)
+}
+
+// AllKRWDeposits 원화 입금 내역을 끝까지 반환한다.
+func (c *Client) AllKRWDeposits(ctx context.Context, req ListDepositsRequest) iter.Seq2[Deposit, error] {
+	req.Currency = string(market.KRW)
+	return c.AllDeposits(ctx, req)
+}
+
+// ListKRWWithdraws 원화 출금 목록 조회 (한 페이지), req.Currency 는 KRW 로 바뀐다.
+func (c *Client) ListKRWWithdraws(ctx context.Context, req ListWithdrawsRequest) ([]Withdraw, error) {
+	req.Currency = string(market.KRW)
+	return c.ListWithdraws(ctx, req)
+}
+
+// AllKRWWithdraws 원화 출금 내역을 끝까지 반환한다.
+func (c *Client) AllKRWWithdraws(ctx context.Context, req ListWithdrawsRequest) iter.Seq2[Withdraw, error] {
+	req.Currency = string(market.KRW)
+	return c.AllWithdraws(ctx, req)
+}
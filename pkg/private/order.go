@@ -0,0 +1,167 @@
+package private
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/wooobo/go-upbit-client/pkg/decimal"
+)
+
+// OrdType 주문 타입
+type OrdType string
+
+func (o OrdType) String() string { return string(o) }
+
+const (
+	OrdTypeLimit  OrdType = "limit"  // 지정가 주문 (Price, Volume 필수)
+	OrdTypePrice  OrdType = "price"  // 시장가 매수 (Price 에 총액, Volume 비움)
+	OrdTypeMarket OrdType = "market" // 시장가 매도 (Volume 필수, Price 비움)
+	OrdTypeBest   OrdType = "best"   // 최유리 지정가 (매수는 Price 에 총액, 매도는 Volume, IOC 또는 FOK 필수)
+)
+
+// TimeInForce 주문 체결 조건
+type TimeInForce string
+
+func (t TimeInForce) String() string { return string(t) }
+
+const (
+	TimeInForceIOC TimeInForce = "ioc" // 즉시 체결 가능한 수량만 체결하고 나머지는 취소
+	TimeInForceFOK TimeInForce = "fok" // 전량 즉시 체결되지 않으면 모두 취소
+	// TimeInForcePostOnly 메이커로만 체결, 즉시 체결될 주문이면 취소 (지정가 주문만, SMPType 과 함께 사용할 수 없음)
+	TimeInForcePostOnly TimeInForce = "post_only"
+)
+
+// SMPType 자전거래 체결 방지 (Self-Match Prevention) 모드
+// 같은 계정의 매수/매도 주문이 서로 체결되려 할 때 어느 주문을 취소할지 정한다.
+type SMPType string
+
+func (s SMPType) String() string { return string(s) }
+
+const (
+	SMPCancelMaker SMPType = "cancel_maker" // 메이커 주문 (먼저 들어온 주문) 취소
+	SMPCancelTaker SMPType = "cancel_taker" // 테이커 주문 (새 주문) 취소
+	SMPReduce      SMPType = "reduce"       // 두 주문의 수량을 겹치는 만큼 줄이고, 0 이 된 주문은 취소
+)
+
+var ErrInvalidOrder = errors.New("invalid order")
+
+// LimitBuy 지정가 매수
+func LimitBuy(market MarketCode, price, volume decimal.Decimal) PlaceOrderRequest {
+	return PlaceOrderRequest{Market: market, Side: OrderSideBid, OrdType: OrdTypeLimit, Price: price, Volume: volume}
+}
+
+// LimitSell 지정가 매도
+func LimitSell(market MarketCode, price, volume decimal.Decimal) PlaceOrderRequest {
+	return PlaceOrderRequest{Market: market, Side: OrderSideAsk, OrdType: OrdTypeLimit, Price: price, Volume: volume}
+}
+
+// MarketBuyByAmount 시장가 매수, amount 는 기준 화폐 총액 (ex. 10000 KRW)
+func MarketBuyByAmount(market MarketCode, amount decimal.Decimal) PlaceOrderRequest {
+	return PlaceOrderRequest{Market: market, Side: OrderSideBid, OrdType: OrdTypePrice, Price: amount}
+}
+
+// MarketSell 시장가 매도
+func MarketSell(market MarketCode, volume decimal.Decimal) PlaceOrderRequest {
+	return PlaceOrderRequest{Market: market, Side: OrderSideAsk, OrdType: OrdTypeMarket, Volume: volume}
+}
+
+// BestBid 최유리 지정가 매수, amount 는 기준 화폐 총액
+func BestBid(market MarketCode, amount decimal.Decimal, tif TimeInForce) PlaceOrderRequest {
+	return PlaceOrderRequest{Market: market, Side: OrderSideBid, OrdType: OrdTypeBest, Price: amount, TimeInForce: tif}
+}
+
+// BestAsk 최유리 지정가 매도
+func BestAsk(market MarketCode, volume decimal.Decimal, tif TimeInForce) PlaceOrderRequest {
+	return PlaceOrderRequest{Market: market, Side: OrderSideAsk, OrdType: OrdTypeBest, Volume: volume, TimeInForce: tif}
+}
+
+// WithIdentifier 조회용 사용자 지정값을 설정한 복사본
+func (r PlaceOrderRequest) WithIdentifier(identifier string) PlaceOrderRequest {
+	r.Identifier = identifier
+	return r
+}
+
+// WithTimeInForce 체결 조건을 설정한 복사본 (ex. 지정가 IOC 주문)
+func (r PlaceOrderRequest) WithTimeInForce(tif TimeInForce) PlaceOrderRequest {
+	r.TimeInForce = tif
+	return r
+}
+
+// WithSMPType 자전거래 체결 방지 모드를 설정한 복사본
+func (r PlaceOrderRequest) WithSMPType(smp SMPType) PlaceOrderRequest {
+	r.SMPType = smp
+	return r
+}
+
+// Validate 주문 타입별로 Price, Volume, TimeInForce 조합이 올바른지 확인한다.
+func (r PlaceOrderRequest) Validate() error {
+	if err := r.Market.Validate(); err != nil {
+		return err
+	}
+	if r.Side != OrderSideBid && r.Side != OrderSideAsk {
+		return fmt.Errorf("%w: unknown side %q", ErrInvalidOrder, r.Side)
+	}
+	switch r.TimeInForce {
+	case "", TimeInForceIOC, TimeInForceFOK:
+	case TimeInForcePostOnly:
+		if r.OrdType != OrdTypeLimit {
+			return fmt.Errorf("%w: %s is only supported for limit orders", ErrInvalidOrder, r.TimeInForce)
+		}
+		if r.SMPType != "" {
+			return fmt.Errorf("%w: %s can not be used with smp_type", ErrInvalidOrder, r.TimeInForce)
+		}
+	default:
+		return fmt.Errorf("%w: unknown time_in_force %q", ErrInvalidOrder, r.TimeInForce)
+	}
+	switch r.SMPType {
+	case "", SMPCancelMaker, SMPCancelTaker, SMPReduce:
+	default:
+		return fmt.Errorf("%w: unknown smp_type %q", ErrInvalidOrder, r.SMPType)
+	}
+
+	switch r.OrdType {
+	case OrdTypeLimit:
+		return r.require(true, true)
+	case OrdTypePrice:
+		if r.Side != OrderSideBid {
+			return fmt.Errorf("%w: %s order must be bid", ErrInvalidOrder, r.OrdType)
+		}
+		if r.TimeInForce != "" {
+			return fmt.Errorf("%w: %s order does not support time_in_force", ErrInvalidOrder, r.OrdType)
+		}
+		return r.require(true, false)
+	case OrdTypeMarket:
+		if r.Side != OrderSideAsk {
+			return fmt.Errorf("%w: %s order must be ask", ErrInvalidOrder, r.OrdType)
+		}
+		if r.TimeInForce != "" {
+			return fmt.Errorf("%w: %s order does not support time_in_force", ErrInvalidOrder, r.OrdType)
+		}
+		return r.require(false, true)
+	case OrdTypeBest:
+		if r.TimeInForce != TimeInForceIOC && r.TimeInForce != TimeInForceFOK {
+			return fmt.Errorf("%w: %s order requires time_in_force ioc or fok", ErrInvalidOrder, r.OrdType)
+		}
+		return r.require(r.Side == OrderSideBid, r.Side == OrderSideAsk)
+	default:
+		return fmt.Errorf("%w: unknown ord_type %q", ErrInvalidOrder, r.OrdType)
+	}
+}
+
+// require price, volume 이 true 이면 양수여야 하고 false 이면 비어 있어야 한다.
+func (r PlaceOrderRequest) require(price, volume bool) error {
+	if price != r.Price.IsPositive() || (!price && !r.Price.IsZero()) {
+		return fmt.Errorf("%w: %s %s order %s price, got %s", ErrInvalidOrder, r.OrdType, r.Side, requirement(price), r.Price)
+	}
+	if volume != r.Volume.IsPositive() || (!volume && !r.Volume.IsZero()) {
+		return fmt.Errorf("%w: %s %s order %s volume, got %s", ErrInvalidOrder, r.OrdType, r.Side, requirement(volume), r.Volume)
+	}
+	return nil
+}
+
+func requirement(required bool) string {
+	if required {
+		return "requires positive"
+	}
+	return "must not set"
+}
@@ -0,0 +1,52 @@
+package private
+
+import (
+	"context"
+	"iter"
+)
+
+// maxListLimit 입출금 목록 조회 한 번에 받을 수 있는 최대 개수
+const maxListLimit = 100
+
+// pageByUUID UUID 커서로 다음 페이지를 요청하며 목록을 끝까지 반환한다.
+// 내림차순이면 마지막 항목의 UUID 를 to 로, 오름차순이면 from 으로 넘긴다.
+// 커서 항목이 다음 페이지에 다시 포함되어도 중복 없이 반환하며, 새 항목이 없거나 limit 보다 적게 받으면 끝낸다.
+func pageByUUID[T any](ctx context.Context, limit int, orderBy OrderBy, uuidOf func(T) string,
+	fetch func(ctx context.Context, from, to string) ([]T, error)) iter.Seq2[T, error] {
+	if limit <= 0 || limit > maxListLimit {
+		limit = maxListLimit
+	}
+	return func(yield func(T, error) bool) {
+		seen := make(map[string]struct{})
+		var from, to string
+		for {
+			page, err := fetch(ctx, from, to)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			last := ""
+			for _, item := range page {
+				uuid := uuidOf(item)
+				if _, ok := seen[uuid]; ok {
+					continue
+				}
+				seen[uuid] = struct{}{}
+				last = uuid
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if last == "" || len(page) < limit {
+				return
+			}
+			if orderBy == OrderByAsc {
+				from = last
+			} else {
+				to = last
+			}
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package private
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/wooobo/go-upbit-client/pkg/decimal"
+)
+
+// DefaultOrderChanceTTL 주문 전 확인에 사용하는 주문 가능 정보의 기본 캐시 유지 시간
+const DefaultOrderChanceTTL = 5 * time.Second
+
+var (
+	ErrOrderTypeNotAllowed = errors.New("order type not allowed")
+	ErrUnderMinTotal       = errors.New("order total under minimum")
+	ErrOverMaxTotal        = errors.New("order total over maximum")
+	ErrInsufficientBalance = errors.New("insufficient available balance")
+)
+
+// Check 주문 가능 정보로 주문을 확인한다.
+// 주문 방향과 타입이 허용되는지, 주문 금액이 최소/최대 금액 안에 있는지, 수수료를 포함해 주문 가능 잔고가 충분한지 본다.
+// 시장가 매도처럼 가격이 없는 주문은 주문 금액을 알 수 없으므로 잔고만 확인한다.
+func (oc OrderChance) Check(order PlaceOrderRequest) error {
+	side := order.Side
+	if len(oc.Market.OrderSides) > 0 && !slices.Contains(oc.Market.OrderSides, side.String()) {
+		return fmt.Errorf("%w: %s %s", ErrOrderTypeNotAllowed, order.Market, side)
+	}
+
+	// bid_types, ask_types 에는 IOC, FOK 조합만 따로 표시된다. (ex. limit_ioc, best_fok)
+	orderType := order.OrdType.String()
+	if order.TimeInForce == TimeInForceIOC || order.TimeInForce == TimeInForceFOK {
+		orderType += "_" + order.TimeInForce.String()
+	}
+	types := oc.BidTypes
+	if side == OrderSideAsk {
+		types = oc.AskTypes
+	}
+	if len(types) > 0 && !slices.Contains(types, orderType) {
+		return fmt.Errorf("%w: %s %s %s, allowed %v", ErrOrderTypeNotAllowed, order.Market, side, orderType, types)
+	}
+
+	total, hasTotal := orderTotal(order)
+	if hasTotal {
+		minTotal := oc.Market.Bid.MinTotal
+		if side == OrderSideAsk {
+			minTotal = oc.Market.Ask.MinTotal
+		}
+		if total.LessThan(minTotal) {
+			return fmt.Errorf("%w: %s %s total %s < %s", ErrUnderMinTotal, order.Market, side, total, minTotal)
+		}
+		if oc.Market.MaxTotal.IsPositive() && total.GreaterThan(oc.Market.MaxTotal) {
+			return fmt.Errorf("%w: %s %s total %s > %s", ErrOverMaxTotal, order.Market, side, total, oc.Market.MaxTotal)
+		}
+	}
+
+	switch side {
+	case OrderSideBid:
+		if !hasTotal {
+			return nil
+		}
+		required := total.Add(total.Mul(oc.BidFee))
+		if required.GreaterThan(oc.BidAccount.Balance) {
+			return fmt.Errorf("%w: %s bid requires %s %s including fee, available %s",
+				ErrInsufficientBalance, order.Market, required, oc.BidAccount.Currency, oc.BidAccount.Balance)
+		}
+	case OrderSideAsk:
+		if order.Volume.GreaterThan(oc.AskAccount.Balance) {
+			return fmt.Errorf("%w: %s ask requires %s %s, available %s",
+				ErrInsufficientBalance, order.Market, order.Volume, oc.AskAccount.Currency, oc.AskAccount.Balance)
+		}
+	}
+	return nil
+}
+
+// orderTotal 주문 금액. 지정가는 가격 x 수량, 시장가/최유리 매수는 주문 가격(총액)이다.
+func orderTotal(order PlaceOrderRequest) (decimal.Decimal, bool) {
+	switch {
+	case order.OrdType == OrdTypeLimit:
+		return order.Price.Mul(order.Volume), true
+	case order.Side == OrderSideBid && (order.OrdType == OrdTypePrice || order.OrdType == OrdTypeBest):
+		return order.Price, true
+	default:
+		return decimal.Zero, false
+	}
+}
+
+type cachedChance struct {
+	chance    OrderChance
+	fetchedAt time.Time
+}
+
+// chanceCache 마켓별 주문 가능 정보 캐시
+type chanceCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[MarketCode]cachedChance
+}
+
+func newChanceCache(ttl time.Duration) *chanceCache {
+	if ttl <= 0 {
+		ttl = DefaultOrderChanceTTL
+	}
+	return &chanceCache{ttl: ttl, entries: make(map[MarketCode]cachedChance)}
+}
+
+func (c *chanceCache) get(market MarketCode) (OrderChance, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[market]
+	if !ok || time.Since(entry.fetchedAt) >= c.ttl {
+		return OrderChance{}, false
+	}
+	return entry.chance, true
+}
+
+func (c *chanceCache) set(market MarketCode, chance OrderChance) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[market] = cachedChance{chance: chance, fetchedAt: time.Now()}
+}
+
+func (c *chanceCache) delete(market MarketCode) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, market)
+}
+
+// CheckOrder 캐시된 주문 가능 정보로 주문을 확인한다. 캐시가 없거나 만료되었으면 GetOrderChance 로 다시 받는다.
+// 캐시된 잔고는 최신이 아닐 수 있으므로 통과하더라도 서버에서 거부될 수 있다.
+func (c *Client) CheckOrder(ctx context.Context, order PlaceOrderRequest) error {
+	chance, ok := c.chances.get(order.Market)
+	if !ok {
+		var err error
+		chance, err = c.GetOrderChance(ctx, order.Market)
+		if err != nil {
+			return err
+		}
+		c.chances.set(order.Market, chance)
+	}
+	return chance.Check(order)
+}
+
+// InvalidateOrderChance 마켓의 캐시된 주문 가능 정보를 지운다. 다른 곳에서 주문하거나 입출금한 뒤에 사용한다.
+func (c *Client) InvalidateOrderChance(market MarketCode) {
+	c.chances.delete(market)
+}
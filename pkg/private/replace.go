@@ -0,0 +1,166 @@
+package private
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/wooobo/go-upbit-client/pkg/decimal"
+)
+
+// RemainOnly new_volume 에 전달하면 기존 주문의 남은 수량으로 새 주문을 낸다.
+const RemainOnly = "remain_only"
+
+// ReplaceOrderRequest 취소 후 재주문 요청
+// PrevOrderUUID, PrevOrderIdentifier 중 하나로 기존 주문을 지정한다.
+type ReplaceOrderRequest struct {
+	PrevOrderUUID       string          // 취소할 주문 UUID
+	PrevOrderIdentifier string          // 취소할 주문의 조회용 사용자 지정값
+	NewOrdType          OrdType         // 새 주문 타입
+	NewPrice            decimal.Decimal // 새 주문 가격 (시장가 매수, 최유리 매수는 총액)
+	NewVolume           decimal.Decimal // 새 주문 수량
+	NewRemainOnly       bool            // true 이면 NewVolume 대신 기존 주문의 남은 수량 사용
+	NewIdentifier       string          // 새 주문의 조회용 사용자 지정값
+	NewTimeInForce      TimeInForce     // 새 주문 체결 조건
+	NewSMPType          SMPType         // 새 주문 자전거래 체결 방지 모드
+}
+
+// Validate 기존 주문 지정과 새 주문 타입별 가격, 수량 조합을 확인한다.
+// 기존 주문의 방향(매수/매도)은 알 수 없으므로 주문 타입에 필요한 필드만 확인한다.
+func (r ReplaceOrderRequest) Validate() error {
+	if (r.PrevOrderUUID == "") == (r.PrevOrderIdentifier == "") {
+		return fmt.Errorf("%w: exactly one of prev_order_uuid or prev_order_identifier is required", ErrInvalidOrder)
+	}
+	if r.NewRemainOnly && !r.NewVolume.IsZero() {
+		return fmt.Errorf("%w: new_volume must not be set with remain_only", ErrInvalidOrder)
+	}
+	hasVolume := r.NewRemainOnly || r.NewVolume.IsPositive()
+
+	switch r.NewOrdType {
+	case OrdTypeLimit:
+		if !r.NewPrice.IsPositive() || !hasVolume {
+			return fmt.Errorf("%w: %s order requires new_price and new_volume", ErrInvalidOrder, r.NewOrdType)
+		}
+	case OrdTypePrice:
+		if !r.NewPrice.IsPositive() || hasVolume {
+			return fmt.Errorf("%w: %s order requires new_price only", ErrInvalidOrder, r.NewOrdType)
+		}
+	case OrdTypeMarket:
+		if !r.NewPrice.IsZero() || !hasVolume {
+			return fmt.Errorf("%w: %s order requires new_volume only", ErrInvalidOrder, r.NewOrdType)
+		}
+	case OrdTypeBest:
+		if r.NewPrice.IsPositive() == hasVolume {
+			return fmt.Errorf("%w: %s order requires either new_price (bid) or new_volume (ask)", ErrInvalidOrder, r.NewOrdType)
+		}
+		if r.NewTimeInForce != TimeInForceIOC && r.NewTimeInForce != TimeInForceFOK {
+			return fmt.Errorf("%w: %s order requires time_in_force ioc or fok", ErrInvalidOrder, r.NewOrdType)
+		}
+	default:
+		return fmt.Errorf("%w: unknown ord_type %q", ErrInvalidOrder, r.NewOrdType)
+	}
+
+	if r.NewTimeInForce == TimeInForcePostOnly && (r.NewOrdType != OrdTypeLimit || r.NewSMPType != "") {
+		return fmt.Errorf("%w: %s is only supported for limit orders without smp_type", ErrInvalidOrder, r.NewTimeInForce)
+	}
+	return nil
+}
+
+// cancelAndNewResponse 취소된 기존 주문과 새 주문의 UUID, 사용자 지정값
+type cancelAndNewResponse struct {
+	Order
+	NewOrderUUID       string `json:"new_order_uuid"`
+	NewOrderIdentifier string `json:"new_order_identifier"`
+}
+
+// ReplaceOrderResult 취소 후 재주문 결과
+type ReplaceOrderResult struct {
+	Cancelled Order       // 취소 접수된 기존 주문
+	New       FilledOrder // 새 주문
+}
+
+// ErrNewOrderNotPlaced 기존 주문은 취소되었지만 새 주문이 접수되지 않음
+var ErrNewOrderNotPlaced = errors.New("new order was not placed")
+
+// ReplaceOrderError 기존 주문의 취소는 접수되었지만 새 주문이 접수되지 않은 경우의 에러
+// 기존 주문은 더 이상 유효하지 않으므로 필요하면 주문을 다시 내야 한다.
+type ReplaceOrderError struct {
+	Cancelled Order // 취소 접수된 기존 주문
+	Err       error
+}
+
+func (e *ReplaceOrderError) Error() string {
+	return fmt.Sprintf("order %s cancelled but replacement failed: %v", e.Cancelled.UUID, e.Err)
+}
+
+func (e *ReplaceOrderError) Unwrap() error { return e.Err }
+
+// NewOrderLookupError 취소 후 재주문은 접수되었지만 새 주문 조회에 실패한 경우의 에러
+// 새 주문은 유효하므로 NewOrderUUID 로 상태를 다시 조회하면 된다.
+type NewOrderLookupError struct {
+	NewOrderUUID string // 새 주문 UUID
+	Err          error
+}
+
+func (e *NewOrderLookupError) Error() string {
+	return fmt.Sprintf("order replaced with %s but lookup failed: %v", e.NewOrderUUID, e.Err)
+}
+
+func (e *NewOrderLookupError) Unwrap() error { return e.Err }
+
+// ReplaceOrder 취소 후 재주문
+// 기존 주문을 취소하고 같은 마켓, 같은 방향으로 새 주문을 한 번의 요청으로 낸다.
+// 취소가 거부되면 기존 주문은 그대로 남고 *APIError 를 반환한다.
+// 취소는 접수되었지만 새 주문이 없으면 *ReplaceOrderError 와 함께 취소된 주문을 담은 결과를 반환한다.
+// 새 주문은 접수되었지만 조회에 실패하면 *NewOrderLookupError 와 함께 새 주문 UUID 를 담은 결과를 반환한다.
+func (c *Client) ReplaceOrder(ctx context.Context, req ReplaceOrderRequest) (ReplaceOrderResult, error) {
+	if err := req.Validate(); err != nil {
+		return ReplaceOrderResult{}, err
+	}
+	path := "/orders/cancel_and_new"
+
+	values := url.Values{}
+	if req.PrevOrderUUID != "" {
+		values.Set("prev_order_uuid", req.PrevOrderUUID)
+	}
+	if req.PrevOrderIdentifier != "" {
+		values.Set("prev_order_identifier", req.PrevOrderIdentifier)
+	}
+	values.Set("new_ord_type", req.NewOrdType.String())
+	if req.NewRemainOnly {
+		values.Set("new_volume", RemainOnly)
+	} else if !req.NewVolume.IsZero() {
+		values.Set("new_volume", req.NewVolume.String())
+	}
+	if !req.NewPrice.IsZero() {
+		values.Set("new_price", req.NewPrice.String())
+	}
+	if req.NewIdentifier != "" {
+		values.Set("new_identifier", req.NewIdentifier)
+	}
+	if req.NewTimeInForce != "" {
+		values.Set("new_time_in_force", req.NewTimeInForce.String())
+	}
+	if req.NewSMPType != "" {
+		values.Set("new_smp_type", req.NewSMPType.String())
+	}
+
+	var resp cancelAndNewResponse
+	if err := c.Post(ctx, path, values, &resp); err != nil {
+		return ReplaceOrderResult{}, fmt.Errorf("failed to replace order: %w", err)
+	}
+
+	result := ReplaceOrderResult{Cancelled: resp.Order}
+	if resp.NewOrderUUID == "" {
+		return result, &ReplaceOrderError{Cancelled: resp.Order, Err: ErrNewOrderNotPlaced}
+	}
+
+	placed, err := c.GetFilledOrder(ctx, resp.NewOrderUUID)
+	if err != nil {
+		result.New.UUID = resp.NewOrderUUID
+		return result, &NewOrderLookupError{NewOrderUUID: resp.NewOrderUUID, Err: err}
+	}
+	result.New = placed
+	return result, nil
+}
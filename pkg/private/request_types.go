@@ -1,5 +1,21 @@
 package private
 
+import (
+	"github.com/wooobo/go-upbit-client/pkg/decimal"
+	"github.com/wooobo/go-upbit-client/pkg/market"
+)
+
+// MarketCode 마켓 코드 (ex. KRW-BTC), 요청을 보내기 전에 검증한다.
+type MarketCode = market.Code
+
+// validateMarket 선택 항목인 마켓 코드는 지정된 경우에만 검증한다.
+func validateMarket(code MarketCode) error {
+	if code == "" {
+		return nil
+	}
+	return code.Validate()
+}
+
 type State string
 
 const (
@@ -32,13 +48,14 @@ const (
 )
 
 type PlaceOrderRequest struct {
-	Market      string    `json:"market"`                  // 마켓 ID
-	Side        OrderSide `json:"side"`                    // 주문 종류
-	Volume      string    `json:"volume"`                  // 주문량
-	Price       string    `json:"price"`                   // 주문 가격
-	OrdType     string    `json:"ord_type"`                // 주문 타입
-	Identifier  string    `json:"identifier,omitempty"`    // 조회용 사용자 지정값
-	TimeInForce string    `json:"time_in_force,omitempty"` // IOC, FOK 주문 설정
+	Market      MarketCode      `json:"market"`                  // 마켓 ID
+	Side        OrderSide       `json:"side"`                    // 주문 종류
+	Volume      decimal.Decimal `json:"volume"`                  // 주문량
+	Price       decimal.Decimal `json:"price"`                   // 주문 가격
+	OrdType     OrdType         `json:"ord_type"`                // 주문 타입
+	Identifier  string          `json:"identifier,omitempty"`    // 조회용 사용자 지정값
+	TimeInForce TimeInForce     `json:"time_in_force,omitempty"` // IOC, FOK, post_only 주문 설정
+	SMPType     SMPType         `json:"smp_type,omitempty"`      // 자전거래 체결 방지 모드
 }
 
 type CancelOrderRequest struct {
@@ -47,55 +64,55 @@ type CancelOrderRequest struct {
 }
 
 type AccountStatus struct {
-	Currency            string       `json:"currency"`
-	Balance             string       `json:"balance"`
-	Locked              string       `json:"locked"`
-	AvgBuyPrice         NumberString `json:"avg_buy_price"`
-	AvgBuyPriceModified bool         `json:"avg_buy_price_modified"`
-	UnitCurrency        string       `json:"unit_currency"`
+	Currency            string          `json:"currency"`
+	Balance             decimal.Decimal `json:"balance"`
+	Locked              decimal.Decimal `json:"locked"`
+	AvgBuyPrice         decimal.Decimal `json:"avg_buy_price"`
+	AvgBuyPriceModified bool            `json:"avg_buy_price_modified"`
+	UnitCurrency        string          `json:"unit_currency"`
 }
 
 type Constraint struct {
-	Currency  string `json:"currency"`
-	PriceUnit string `json:"price_unit"`
-	MinTotal  string `json:"min_total"`
+	Currency  string          `json:"currency"`
+	PriceUnit decimal.Decimal `json:"price_unit"`
+	MinTotal  decimal.Decimal `json:"min_total"`
 }
 
 type MarketTicker struct {
-	ID         string     `json:"id"`
-	Name       string     `json:"name"`
-	OrderTypes []string   `json:"order_types,omitempty"` // 만료된 필드이므로 사용하지 않음
-	OrderSides []string   `json:"order_sides"`
-	Bid        Constraint `json:"bid"`
-	Ask        Constraint `json:"ask"`
-	MaxTotal   string     `json:"max_total"`
-	State      string     `json:"state"`
+	ID         string          `json:"id"`
+	Name       string          `json:"name"`
+	OrderTypes []string        `json:"order_types,omitempty"` // 만료된 필드이므로 사용하지 않음
+	OrderSides []string        `json:"order_sides"`
+	Bid        Constraint      `json:"bid"`
+	Ask        Constraint      `json:"ask"`
+	MaxTotal   decimal.Decimal `json:"max_total"`
+	State      string          `json:"state"`
 }
 
 type OrderChance struct {
-	BidFee     string        `json:"bid_fee"`
-	AskFee     string        `json:"ask_fee"`
-	Market     MarketTicker  `json:"market"`
-	AskTypes   []string      `json:"ask_types"`
-	BidTypes   []string      `json:"bid_types"`
-	BidAccount AccountStatus `json:"bid_account"`
-	AskAccount AccountStatus `json:"ask_account"`
+	BidFee     decimal.Decimal `json:"bid_fee"`
+	AskFee     decimal.Decimal `json:"ask_fee"`
+	Market     MarketTicker    `json:"market"`
+	AskTypes   []string        `json:"ask_types"`
+	BidTypes   []string        `json:"bid_types"`
+	BidAccount AccountStatus   `json:"bid_account"`
+	AskAccount AccountStatus   `json:"ask_account"`
 }
 
 type OrderSearchRequest struct {
-	Market      string   `json:"market"`
-	UUIDs       []string `json:"uuids,omitempty"`
-	Identifiers []string `json:"identifiers,omitempty"`
-	OrderBy     string   `json:"order_by,omitempty"`
+	Market      MarketCode `json:"market"`
+	UUIDs       []string   `json:"uuids,omitempty"`
+	Identifiers []string   `json:"identifiers,omitempty"`
+	OrderBy     string     `json:"order_by,omitempty"`
 }
 
 type OrderQueryParams struct {
-	Market  string  `json:"market"`
-	State   State   `json:"state,omitempty"`
-	States  []State `json:"states,omitempty"`
-	Page    int     `json:"page,omitempty"`
-	Limit   int     `json:"limit,omitempty"`
-	OrderBy OrderBy `json:"order_by,omitempty"`
+	Market  MarketCode `json:"market"`
+	State   State      `json:"state,omitempty"`
+	States  []State    `json:"states,omitempty"`
+	Page    int        `json:"page,omitempty"`
+	Limit   int        `json:"limit,omitempty"`
+	OrderBy OrderBy    `json:"order_by,omitempty"`
 }
 
 type CompletedOrderState string
@@ -110,7 +127,7 @@ func (s CompletedOrderState) String() string {
 }
 
 type CompletedOrderRequest struct {
-	Market    string                `json:"market"`               // 마켓 ID
+	Market    MarketCode            `json:"market"`               // 마켓 ID
 	State     CompletedOrderState   `json:"state,omitempty"`      // 주문 상태
 	States    []CompletedOrderState `json:"states,omitempty"`     // 주문 상태 목록, 기본값: ['done', 'cancel'], state 와 states 는 동시에 사용할 수 없습니다.
 	StartTime string                `json:"start_time,omitempty"` // 조회 시작 시간 (ISO-8601 포맷)
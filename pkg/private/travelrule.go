@@ -0,0 +1,95 @@
+package private
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// VASP 트래블룰을 지원하는 가상자산사업자 (거래소)
+type VASP struct {
+	Name         string `json:"vasp_name"`    // 거래소 이름
+	UUID         string `json:"vasp_uuid"`    // 거래소 식별자, 입금 검증 요청에 사용
+	Depositable  bool   `json:"depositable"`  // 이 거래소로부터 입금 가능 여부
+	Withdrawable bool   `json:"withdrawable"` // 이 거래소로 출금 가능 여부
+}
+
+// VerificationResult 트래블룰 검증 결과
+type VerificationResult string
+
+const (
+	VerificationVerified VerificationResult = "verified" // 검증 성공
+	VerificationFailed   VerificationResult = "failed"   // 검증 실패
+)
+
+// TravelRuleVerification 트래블룰 입금 검증 결과
+type TravelRuleVerification struct {
+	DepositUUID        string             `json:"deposit_uuid"`        // 입금 UUID
+	VerificationResult VerificationResult `json:"verification_result"` // 검증 결과
+	DepositState       DepositState       `json:"deposit_state"`       // 검증 후 입금 상태
+}
+
+// Verified 검증에 성공했는지 확인한다.
+func (v TravelRuleVerification) Verified() bool {
+	return v.VerificationResult == VerificationVerified
+}
+
+// TravelRuleTxIDRequest TxID 로 트래블룰 입금 검증 요청
+type TravelRuleTxIDRequest struct {
+	VASPUUID string // 출금한 거래소의 VASP UUID
+	TxID     string // 입금 트랜잭션 아이디
+	Currency string // 화폐 코드 (ex. BTC)
+	NetType  string // 입금 네트워크
+}
+
+// ListTravelRuleVASPs 트래블룰 지원 거래소 목록
+func (c *Client) ListTravelRuleVASPs(ctx context.Context) ([]VASP, error) {
+	path := "/travel_rule/vasps"
+
+	var resp []VASP
+	if err := c.Get(ctx, path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// VerifyDepositByUUID 입금 UUID 로 트래블룰 검증을 요청한다.
+// 검증에 실패해도 에러가 아니며 결과의 VerificationResult 로 확인한다.
+// 같은 입금은 일정 시간 (10분) 안에 다시 검증을 요청할 수 없다.
+func (c *Client) VerifyDepositByUUID(ctx context.Context, depositUUID, vaspUUID string) (TravelRuleVerification, error) {
+	if depositUUID == "" || vaspUUID == "" {
+		return TravelRuleVerification{}, fmt.Errorf("%w: deposit_uuid and vasp_uuid are required", ErrInvalidDeposit)
+	}
+
+	path := "/travel_rule/deposit/uuid"
+	values := url.Values{}
+	values.Set("deposit_uuid", depositUUID)
+	values.Set("vasp_uuid", vaspUUID)
+
+	var resp TravelRuleVerification
+	if err := c.Post(ctx, path, values, &resp); err != nil {
+		return TravelRuleVerification{}, fmt.Errorf("failed to verify deposit: %w", err)
+	}
+	return resp, nil
+}
+
+// VerifyDepositByTxID 입금 TxID 로 트래블룰 검증을 요청한다.
+// 검증에 실패해도 에러가 아니며 결과의 VerificationResult 로 확인한다.
+func (c *Client) VerifyDepositByTxID(ctx context.Context, req TravelRuleTxIDRequest) (TravelRuleVerification, error) {
+	if req.VASPUUID == "" || req.TxID == "" || req.Currency == "" || req.NetType == "" {
+		return TravelRuleVerification{}, fmt.Errorf("%w: vasp_uuid, txid, currency and net_type are required", ErrInvalidDeposit)
+	}
+
+	path := "/travel_rule/deposit/txid"
+	values := url.Values{}
+	values.Set("vasp_uuid", req.VASPUUID)
+	values.Set("txid", req.TxID)
+	values.Set("currency", req.Currency)
+	values.Set("net_type", req.NetType)
+
+	var resp TravelRuleVerification
+	if err := c.Post(ctx, path, values, &resp); err != nil {
+		return TravelRuleVerification{}, fmt.Errorf("failed to verify deposit: %w", err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,263 @@
+package private
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/wooobo/go-upbit-client/pkg/decimal"
+)
+
+// WithdrawState 출금 상태
+type WithdrawState string
+
+func (s WithdrawState) String() string { return string(s) }
+
+const (
+	WithdrawStateWaiting    WithdrawState = "WAITING"    // 대기 중
+	WithdrawStateProcessing WithdrawState = "PROCESSING" // 진행 중
+	WithdrawStateDone       WithdrawState = "DONE"       // 완료
+	WithdrawStateFailed     WithdrawState = "FAILED"     // 실패
+	WithdrawStateCanceled   WithdrawState = "CANCELED"   // 취소됨
+	WithdrawStateRejected   WithdrawState = "REJECTED"   // 거절됨
+)
+
+// TransactionType 출금 유형
+type TransactionType string
+
+func (t TransactionType) String() string { return string(t) }
+
+const (
+	TransactionTypeDefault  TransactionType = "default"  // 일반 출금
+	TransactionTypeInternal TransactionType = "internal" // 바로 출금 (업비트 회원 간 출금)
+)
+
+var ErrInvalidWithdraw = errors.New("invalid withdraw request")
+
+// Withdraw 출금 내역
+type Withdraw struct {
+	Type            string          `json:"type"`             // 입출금 종류
+	UUID            string          `json:"uuid"`             // 출금의 고유 아이디
+	Currency        string          `json:"currency"`         // 화폐를 의미하는 영문 대문자 코드
+	NetType         string          `json:"net_type"`         // 출금 네트워크
+	TxID            string          `json:"txid"`             // 출금의 트랜잭션 아이디
+	State           WithdrawState   `json:"state"`            // 출금 상태
+	CreatedAt       time.Time       `json:"created_at"`       // 출금 생성 시간
+	DoneAt          *time.Time      `json:"done_at"`          // 출금 완료 시간, 완료 전이면 nil
+	Amount          decimal.Decimal `json:"amount"`           // 출금 금액/수량
+	Fee             decimal.Decimal `json:"fee"`              // 출금 수수료
+	KRWAmount       decimal.Decimal `json:"krw_amount"`       // 원화 환산 가격
+	TransactionType TransactionType `json:"transaction_type"` // 출금 유형
+}
+
+// MemberLevel 회원의 보안 등급과 인증 정보
+type MemberLevel struct {
+	SecurityLevel         int  `json:"security_level"`
+	FeeLevel              int  `json:"fee_level"`
+	EmailVerified         bool `json:"email_verified"`
+	IdentityAuthVerified  bool `json:"identity_auth_verified"`
+	BankAccountVerified   bool `json:"bank_account_verified"`
+	TwoFactorAuthVerified bool `json:"two_factor_auth_verified"`
+	Locked                bool `json:"locked"`
+	WalletLocked          bool `json:"wallet_locked"`
+}
+
+// WithdrawCurrency 출금 화폐 정보
+type WithdrawCurrency struct {
+	Code          string          `json:"code"`           // 화폐 코드
+	WithdrawFee   decimal.Decimal `json:"withdraw_fee"`   // 출금 수수료
+	IsCoin        bool            `json:"is_coin"`        // 디지털 자산 여부
+	WalletState   string          `json:"wallet_state"`   // 지갑 상태 (working, withdraw_only, deposit_only, paused, unsupported)
+	WalletSupport []string        `json:"wallet_support"` // 지원하는 입출금 정보 (deposit, withdraw)
+}
+
+// WithdrawLimit 출금 제한 정보
+type WithdrawLimit struct {
+	Currency            string          `json:"currency"`
+	Minimum             decimal.Decimal `json:"minimum"`               // 출금 최소 금액/수량
+	Onetime             decimal.Decimal `json:"onetime"`               // 1회 출금 한도
+	Daily               decimal.Decimal `json:"daily"`                 // 1일 출금 한도
+	RemainingDaily      decimal.Decimal `json:"remaining_daily"`       // 1일 잔여 출금 한도
+	RemainingDailyKRW   decimal.Decimal `json:"remaining_daily_krw"`   // 통합 1일 잔여 출금 한도 (원화)
+	RemainingDailyFiat  decimal.Decimal `json:"remaining_daily_fiat"`  // 통합 1일 잔여 출금 한도 (법정 화폐)
+	FiatCurrency        string          `json:"fiat_currency"`         // 법정 화폐 코드
+	Fixed               int             `json:"fixed"`                 // 출금 금액/수량 소수점 자리 수
+	WithdrawDelayedFiat decimal.Decimal `json:"withdraw_delayed_fiat"` // 출금 지연 금액 (법정 화폐)
+	CanWithdraw         bool            `json:"can_withdraw"`          // 출금 지원 여부
+}
+
+// WithdrawChance 출금 가능 정보
+type WithdrawChance struct {
+	MemberLevel   MemberLevel      `json:"member_level"`
+	Currency      WithdrawCurrency `json:"currency"`
+	Account       Account          `json:"account"`
+	WithdrawLimit WithdrawLimit    `json:"withdraw_limit"`
+}
+
+// WithdrawRequest 디지털 자산 출금 요청
+type WithdrawRequest struct {
+	Currency         string          // 화폐 코드 (ex. BTC)
+	NetType          string          // 출금 네트워크 (ex. BTC, ETH)
+	Amount           decimal.Decimal // 출금 수량
+	Address          string          // 출금 가능 주소에 등록된 출금 주소
+	SecondaryAddress string          // 2차 출금 주소 (필요한 디지털 자산에 한해서, ex. XRP destination tag)
+	TransactionType  TransactionType // 출금 유형 (default: default)
+}
+
+// WithdrawQuery 개별 출금 조회 조건. UUID, TxID 중 하나는 지정해야 한다.
+type WithdrawQuery struct {
+	UUID     string
+	TxID     string
+	Currency string
+}
+
+// ListWithdrawsRequest 출금 목록 조회 조건
+type ListWithdrawsRequest struct {
+	Currency string
+	State    WithdrawState
+	UUIDs    []string
+	TxIDs    []string
+	Limit    int     // 최대 100 (default: 100)
+	From     string  // 페이지네이션 커서 (UUID)
+	To       string  // 페이지네이션 커서 (UUID)
+	OrderBy  OrderBy // default: desc
+}
+
+// GetWithdrawChance 출금 가능 정보
+func (c *Client) GetWithdrawChance(ctx context.Context, currency, netType string) (WithdrawChance, error) {
+	path := "/withdraws/chance"
+	values := url.Values{}
+	values.Set("currency", currency)
+	values.Set("net_type", netType)
+
+	var resp WithdrawChance
+	if err := c.Get(ctx, path, values, &resp); err != nil {
+		return WithdrawChance{}, err
+	}
+	return resp, nil
+}
+
+// Withdraw 디지털 자산 출금하기
+// 출금 주소는 업비트 웹에서 출금 허용 주소로 등록되어 있어야 한다. 요청은 재시도하지 않는다.
+func (c *Client) Withdraw(ctx context.Context, req WithdrawRequest) (Withdraw, error) {
+	if req.Currency == "" || req.NetType == "" || req.Address == "" {
+		return Withdraw{}, fmt.Errorf("%w: currency, net_type and address are required", ErrInvalidWithdraw)
+	}
+	if !req.Amount.IsPositive() {
+		return Withdraw{}, fmt.Errorf("%w: amount must be positive, got %s", ErrInvalidWithdraw, req.Amount)
+	}
+
+	path := "/withdraws/coin"
+	values := url.Values{}
+	values.Set("currency", req.Currency)
+	values.Set("net_type", req.NetType)
+	values.Set("amount", req.Amount.String())
+	values.Set("address", req.Address)
+	if req.SecondaryAddress != "" {
+		values.Set("secondary_address", req.SecondaryAddress)
+	}
+	if req.TransactionType != "" {
+		values.Set("transaction_type", req.TransactionType.String())
+	}
+
+	var resp Withdraw
+	if err := c.Post(ctx, path, values, &resp); err != nil {
+		return Withdraw{}, fmt.Errorf("failed to withdraw: %w", err)
+	}
+	return resp, nil
+}
+
+// GetWithdraw 개별 출금 조회
+func (c *Client) GetWithdraw(ctx context.Context, query WithdrawQuery) (Withdraw, error) {
+	if query.UUID == "" && query.TxID == "" {
+		return Withdraw{}, fmt.Errorf("%w: uuid or txid is required", ErrInvalidWithdraw)
+	}
+
+	path := "/withdraw"
+	values := url.Values{}
+	if query.UUID != "" {
+		values.Set("uuid", query.UUID)
+	}
+	if query.TxID != "" {
+		values.Set("txid", query.TxID)
+	}
+	if query.Currency != "" {
+		values.Set("currency", query.Currency)
+	}
+
+	var resp Withdraw
+	if err := c.Get(ctx, path, values, &resp); err != nil {
+		return Withdraw{}, err
+	}
+	return resp, nil
+}
+
+// ListWithdraws 출금 목록 조회 (한 페이지)
+func (c *Client) ListWithdraws(ctx context.Context, req ListWithdrawsRequest) ([]Withdraw, error) {
+	path := "/withdraws"
+	values := url.Values{}
+	if req.Currency != "" {
+		values.Set("currency", req.Currency)
+	}
+	if req.State != "" {
+		values.Set("state", req.State.String())
+	}
+	for _, uuid := range req.UUIDs {
+		values.Add("uuids[]", uuid)
+	}
+	for _, txid := range req.TxIDs {
+		values.Add("txids[]", txid)
+	}
+	if req.Limit > 0 {
+		values.Set("limit", strconv.Itoa(req.Limit))
+	}
+	if req.From != "" {
+		values.Set("from", req.From)
+	}
+	if req.To != "" {
+		values.Set("to", req.To)
+	}
+	if req.OrderBy != "" {
+		values.Set("order_by", req.OrderBy.String())
+	}
+
+	var resp []Withdraw
+	if err := c.Get(ctx, path, values, &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// AllWithdraws 조건에 맞는 출금 내역을 UUID 커서로 페이지를 넘기며 끝까지 반환한다.
+// req.From, req.To 는 무시한다. 에러가 발생하면 에러를 한 번 반환하고 끝난다.
+func (c *Client) AllWithdraws(ctx context.Context, req ListWithdrawsRequest) iter.Seq2[Withdraw, error] {
+	if req.Limit <= 0 {
+		req.Limit = maxListLimit
+	}
+	return pageByUUID(ctx, req.Limit, req.OrderBy, func(w Withdraw) string { return w.UUID },
+		func(ctx context.Context, from, to string) ([]Withdraw, error) {
+			req.From, req.To = from, to
+			return c.ListWithdraws(ctx, req)
+		})
+}
+
+// CancelWithdraw 디지털 자산 출금 취소 접수 (WAITING 상태의 출금만 취소할 수 있다)
+func (c *Client) CancelWithdraw(ctx context.Context, uuid string) (Withdraw, error) {
+	if uuid == "" {
+		return Withdraw{}, fmt.Errorf("%w: uuid is required", ErrInvalidWithdraw)
+	}
+
+	path := "/withdraws/coin"
+	values := url.Values{}
+	values.Set("uuid", uuid)
+
+	var resp Withdraw
+	if err := c.Delete(ctx, path, values, &resp); err != nil {
+		return Withdraw{}, fmt.Errorf("failed to cancel withdraw: %w", err)
+	}
+	return resp, nil
+}
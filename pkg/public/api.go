@@ -2,10 +2,14 @@ package public
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
+
+	"github.com/wooobo/go-upbit-client/pkg/market"
 )
 
 // GetMarkets 종목 코드 조회
@@ -27,29 +31,65 @@ func (c *Client) GetMarkets(ctx context.Context, isDetails bool) ([]Market, erro
 	return markets, nil
 }
 
-// GetCandles 캔들 조회
-func (c *Client) GetCandles(ctx context.Context, req CandleRequest) ([]Candle, error) {
-	path := ""
-	switch req.CandleInterval {
+const (
+	candleToLayout     = "2006-01-02T15:04:05Z" // 캔들 조회 to 파라미터 형식 (UTC)
+	tradeTicksToLayout = "15:04:05"             // 체결 조회 to 파라미터 형식 (UTC 시각)
+)
+
+var (
+	ErrInvalidCandleInterval = errors.New("invalid candle interval")
+	ErrInvalidCandleUnit     = errors.New("invalid candle unit")
+	ErrConvertingPriceUnit   = errors.New("convertingPriceUnit is only supported for day candles")
+)
+
+// candlePath 캔들 종류별 요청 경로. 분 캔들은 지원하는 분 단위인지 확인한다.
+func candlePath(interval CandleInterval, unit int) (string, error) {
+	switch interval {
+	case Second:
+		return "/candles/seconds", nil
 	case Minute:
-		path = fmt.Sprintf("%s%s", "/candles/minutes/", strconv.Itoa(req.UnitCount))
+		if !slices.Contains(MinuteUnits, unit) {
+			return "", fmt.Errorf("%w: %d minutes, must be one of %v", ErrInvalidCandleUnit, unit, MinuteUnits)
+		}
+		return "/candles/minutes/" + strconv.Itoa(unit), nil
 	case Day:
-		path = "/candles/days"
+		return "/candles/days", nil
 	case Week:
-		path = "/candles/weeks"
+		return "/candles/weeks", nil
 	case Month:
-		path = "/candles/months"
+		return "/candles/months", nil
+	case Year:
+		return "/candles/years", nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrInvalidCandleInterval, interval)
+	}
+}
+
+// GetCandles 캔들 조회
+func (c *Client) GetCandles(ctx context.Context, req CandleRequest) ([]Candle, error) {
+	if err := req.Market.Validate(); err != nil {
+		return nil, err
+	}
+	path, err := candlePath(req.CandleInterval, req.UnitCount)
+	if err != nil {
+		return nil, err
 	}
 
 	values := url.Values{}
-	values.Set("market", req.Market)
+	values.Set("market", string(req.Market))
 	values.Set("count", fmt.Sprintf("%d", req.Count))
-	if req.To != "" {
-		values.Set("to", req.To)
+	if !req.To.IsZero() {
+		values.Set("to", req.To.UTC().Format(candleToLayout))
+	}
+	if req.ConvertingPriceUnit != "" {
+		if req.CandleInterval != Day {
+			return nil, ErrConvertingPriceUnit
+		}
+		values.Set("convertingPriceUnit", string(req.ConvertingPriceUnit))
 	}
 
 	var candles []Candle
-	err := c.Get(ctx, path, values, &candles)
+	err = c.Get(ctx, path, values, &candles)
 
 	if err != nil {
 		return nil, err
@@ -58,14 +98,19 @@ func (c *Client) GetCandles(ctx context.Context, req CandleRequest) ([]Candle, e
 	return candles, nil
 }
 
-// GetTradeTicks 파라미터 To 는 UTC 기준으로 조회해야함
+// GetTradeTicks 최근 체결 내역 조회
+// 파라미터 To 는 UTC 기준 시각으로 변환되어 전달된다.
 func (c *Client) GetTradeTicks(ctx context.Context, req TradeTicksRequest) ([]TradeTick, error) {
+	if err := req.Market.Validate(); err != nil {
+		return nil, err
+	}
+
 	path := "/trades/ticks"
 	values := url.Values{}
-	values.Set("market", req.Market)
+	values.Set("market", string(req.Market))
 	values.Set("count", fmt.Sprintf("%d", req.Count))
-	if req.To != "" {
-		values.Set("to", req.To)
+	if !req.To.IsZero() {
+		values.Set("to", req.To.UTC().Format(tradeTicksToLayout))
 	}
 	if req.Cursor != "" {
 		values.Set("cursor", req.Cursor)
@@ -85,8 +130,17 @@ func (c *Client) GetTradeTicks(ctx context.Context, req TradeTicksRequest) ([]Tr
 }
 
 // GetTickerPrice 종목 단위 현재가 정보 조회
-// 반점으로 구분되는 종목 코드 (ex. KRW-BTC, BTC-ETH)
-func (c *Client) GetTickerPrice(ctx context.Context, markets []string) ([]TickerSnapshot, error) {
+// 종목 코드 목록 (ex. KRW-BTC, BTC-ETH)
+// 마켓이 많으면 나누어 동시에 조회하며, 일부 마켓이 실패하면 성공한 결과와 함께 *BatchError 를 반환한다.
+func (c *Client) GetTickerPrice(ctx context.Context, markets []MarketCode) ([]TickerSnapshot, error) {
+	codes, err := market.Strings(markets)
+	if err != nil {
+		return nil, err
+	}
+	return fetchMarkets(ctx, codes, c.getTickerPrice)
+}
+
+func (c *Client) getTickerPrice(ctx context.Context, markets []string) ([]TickerSnapshot, error) {
 	path := "/ticker"
 	values := url.Values{}
 	values.Set("markets", strings.Join(markets, ","))
@@ -100,12 +154,16 @@ func (c *Client) GetTickerPrice(ctx context.Context, markets []string) ([]Ticker
 	return resp, nil
 }
 
-type QuoteCurrency string
+// QuoteCurrency 마켓의 기준 화폐
+type QuoteCurrency = market.QuoteCurrency
+
+// MarketCode 마켓 코드 (ex. KRW-BTC), 요청을 보내기 전에 검증한다.
+type MarketCode = market.Code
 
 const (
-	KRW  QuoteCurrency = "KRW"
-	BTC  QuoteCurrency = "BTC"
-	USDT QuoteCurrency = "USDT"
+	KRW  = market.KRW
+	BTC  = market.BTC
+	USDT = market.USDT
 )
 
 // GetAllTickerPrices 마켓 단위 종목들의 스냅샷을 반환합니다.
@@ -131,10 +189,21 @@ func (c *Client) GetAllTickerPrices(ctx context.Context, quoteCurrencies []Quote
 }
 
 // GetOrderBook 호가 정보 조회
-func (c *Client) GetOrderBook(ctx context.Context, markets []string, level float64) ([]OrderBook, error) {
+// 마켓이 많으면 나누어 동시에 조회하며, 일부 마켓이 실패하면 성공한 결과와 함께 *BatchError 를 반환한다.
+func (c *Client) GetOrderBook(ctx context.Context, markets []MarketCode, level float64) ([]OrderBook, error) {
+	codes, err := market.Strings(markets)
+	if err != nil {
+		return nil, err
+	}
+	return fetchMarkets(ctx, codes, func(ctx context.Context, markets []string) ([]OrderBook, error) {
+		return c.getOrderBook(ctx, markets, level)
+	})
+}
+
+func (c *Client) getOrderBook(ctx context.Context, markets []string, level float64) ([]OrderBook, error) {
 	path := "/orderbook"
 	values := url.Values{}
-	values.Set("markets", strings.Join(markets, ", "))
+	values.Set("markets", strings.Join(markets, ","))
 	if level != 0 {
 		values.Set("level", strconv.FormatFloat(level, 'f', -1, 64))
 	}
@@ -150,10 +219,19 @@ func (c *Client) GetOrderBook(ctx context.Context, markets []string, level float
 
 // GetOrderBookSupportedLevels 호가 모아보기 단위 정보 조회
 // 호가 모아보기 기능은 원화마켓(KRW)에서만 지원하므로 BTC, USDT 마켓의 경우 0만 존재합니다.
-func (c *Client) GetOrderBookSupportedLevels(ctx context.Context, markets []string) ([]SupportedLevels, error) {
+// 마켓이 많으면 나누어 동시에 조회하며, 일부 마켓이 실패하면 성공한 결과와 함께 *BatchError 를 반환한다.
+func (c *Client) GetOrderBookSupportedLevels(ctx context.Context, markets []MarketCode) ([]SupportedLevels, error) {
+	codes, err := market.Strings(markets)
+	if err != nil {
+		return nil, err
+	}
+	return fetchMarkets(ctx, codes, c.getOrderBookSupportedLevels)
+}
+
+func (c *Client) getOrderBookSupportedLevels(ctx context.Context, markets []string) ([]SupportedLevels, error) {
 	path := "/orderbook/supported_levels"
 	values := url.Values{}
-	values.Set("markets", strings.Join(markets, ", "))
+	values.Set("markets", strings.Join(markets, ","))
 
 	var resp []SupportedLevels
 	err := c.Get(ctx, path, values, &resp)
@@ -123,11 +123,11 @@ func TestClient_GetTickerPrice(t *testing.T) {
 
 	tests := []struct {
 		name    string
-		markets []string
+		markets []MarketCode
 	}{
 		{
 			name:    "GetTickerPrice()",
-			markets: []string{"KRW-BTC, BTC-ETH"},
+			markets: []MarketCode{"KRW-BTC", "BTC-ETH"},
 		},
 	}
 
@@ -175,12 +175,12 @@ func TestClient_GetOrderBook(t *testing.T) {
 
 	tests := []struct {
 		name    string
-		markets []string
+		markets []MarketCode
 		level   float64
 	}{
 		{
 			name:    "GetOrderBook()",
-			markets: []string{"KRW-BTC, BTC-ETH"},
+			markets: []MarketCode{"KRW-BTC", "BTC-ETH"},
 			level:   0,
 		},
 	}
@@ -203,11 +203,11 @@ func TestClient_GetOrderBookSupportedLevels(t *testing.T) {
 
 	tests := []struct {
 		name    string
-		markets []string
+		markets []MarketCode
 	}{
 		{
 			name:    "GetOrderBookSupportedLevels()",
-			markets: []string{"KRW-BTC, BTC-ETH"},
+			markets: []MarketCode{"KRW-BTC", "BTC-ETH"},
 		},
 	}
 
@@ -0,0 +1,128 @@
+package public
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/wooobo/go-upbit-client/pkg/apierror"
+)
+
+const (
+	marketBatchSize        = 100 // 요청 한 번에 보내는 최대 마켓 수
+	marketBatchConcurrency = 4   // 동시에 보내는 최대 요청 수
+)
+
+// BatchError 여러 마켓을 나누어 조회할 때 일부 마켓이 실패한 경우의 에러
+// 성공한 마켓의 결과는 에러와 함께 반환된다.
+type BatchError struct {
+	Failures map[string]error // 마켓 코드별 실패 원인
+}
+
+func (e *BatchError) Error() string {
+	if len(e.Failures) == 0 {
+		return "batch request failed"
+	}
+	markets := make([]string, 0, len(e.Failures))
+	for market := range e.Failures {
+		markets = append(markets, market)
+	}
+	sort.Strings(markets)
+
+	return fmt.Sprintf("%d markets failed: %s: %v", len(markets), strings.Join(markets, ","), e.Failures[markets[0]])
+}
+
+// Unwrap errors.Is, errors.As 로 개별 실패 원인을 확인할 수 있도록 한다.
+func (e *BatchError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Failures))
+	for _, err := range e.Failures {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// fetchMarkets markets 를 marketBatchSize 단위로 나누어 동시에 조회하고 결과를 요청 순서대로 합친다.
+// 잘못된 마켓 코드로 실패한 묶음은 반으로 나누어 다시 조회해 실패한 마켓만 골라낸다.
+func fetchMarkets[T any](ctx context.Context, markets []string, fetch func(ctx context.Context, markets []string) ([]T, error)) ([]T, error) {
+	if len(markets) == 0 {
+		return fetch(ctx, markets)
+	}
+
+	var chunks [][]string
+	for start := 0; start < len(markets); start += marketBatchSize {
+		chunks = append(chunks, markets[start:min(start+marketBatchSize, len(markets))])
+	}
+
+	results := make([][]T, len(chunks))
+	failures := make([]map[string]error, len(chunks))
+	sem := make(chan struct{}, marketBatchConcurrency)
+	var wg sync.WaitGroup
+	for i, chunk := range chunks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				failures[i] = failAll(chunk, ctx.Err())
+				return
+			}
+			failures[i] = make(map[string]error)
+			results[i] = fetchChunk(ctx, chunk, fetch, failures[i])
+		}()
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var merged []T
+	batchErr := &BatchError{Failures: make(map[string]error)}
+	for i := range chunks {
+		merged = append(merged, results[i]...)
+		for market, err := range failures[i] {
+			batchErr.Failures[market] = err
+		}
+	}
+	if len(batchErr.Failures) > 0 {
+		return merged, batchErr
+	}
+	return merged, nil
+}
+
+func fetchChunk[T any](ctx context.Context, chunk []string, fetch func(ctx context.Context, markets []string) ([]T, error), failures map[string]error) []T {
+	resp, err := fetch(ctx, chunk)
+	if err == nil {
+		return resp
+	}
+	if len(chunk) == 1 || !isMarketError(err) {
+		for market, e := range failAll(chunk, err) {
+			failures[market] = e
+		}
+		return nil
+	}
+
+	half := len(chunk) / 2
+	left := fetchChunk(ctx, chunk[:half], fetch, failures)
+	right := fetchChunk(ctx, chunk[half:], fetch, failures)
+	return append(left, right...)
+}
+
+// isMarketError 잘못된 마켓 코드 때문에 실패했을 수 있는 에러인지 확인한다. (400, 404)
+func isMarketError(err error) bool {
+	status := apierror.StatusCode(err)
+	return status == http.StatusBadRequest || status == http.StatusNotFound
+}
+
+func failAll(markets []string, err error) map[string]error {
+	failures := make(map[string]error, len(markets))
+	for _, market := range markets {
+		failures[market] = err
+	}
+	return failures
+}
@@ -0,0 +1,95 @@
+package public
+
+import (
+	"context"
+	"iter"
+	"time"
+
+	"github.com/wooobo/go-upbit-client/pkg/ratelimit"
+)
+
+// maxCandleCount 캔들 조회 한 번에 받을 수 있는 최대 개수
+const maxCandleCount = 200
+
+// CandleRange From 이상 To 미만의 캔들을 최신 캔들부터 과거 방향으로 반환한다.
+// 200개 단위로 To 를 옮기며 자동으로 페이지를 넘기고, 페이지 경계에서 중복된 캔들은 건너뛴다.
+// 에러가 발생하면 에러를 한 번 반환하고 끝난다.
+func (c *Client) CandleRange(ctx context.Context, req CandleRangeRequest) iter.Seq2[Candle, error] {
+	return func(yield func(Candle, error) bool) {
+		to := req.To
+		var last time.Time
+
+		for {
+			candles, err := c.GetCandles(ctx, CandleRequest{
+				Market:              req.Market,
+				To:                  to,
+				Count:               maxCandleCount,
+				CandleInterval:      req.CandleInterval,
+				UnitCount:           req.UnitCount,
+				ConvertingPriceUnit: req.ConvertingPriceUnit,
+			})
+			if err != nil {
+				yield(Candle{}, err)
+				return
+			}
+
+			progressed := false
+			for _, candle := range candles {
+				at := candle.CandleDateTimeUTC
+				if !last.IsZero() && !at.Before(last) {
+					continue
+				}
+				if !req.From.IsZero() && at.Before(req.From) {
+					return
+				}
+				last = at
+				progressed = true
+				if !yield(candle, nil) {
+					return
+				}
+			}
+			if !progressed || len(candles) < maxCandleCount {
+				return
+			}
+			to = last
+
+			if err := c.waitRemaining(ctx, ratelimit.GroupCandles); err != nil {
+				yield(Candle{}, err)
+				return
+			}
+		}
+	}
+}
+
+// GetCandleRange CandleRange 의 결과를 모두 모아 최신 캔들부터 과거 순으로 반환한다.
+func (c *Client) GetCandleRange(ctx context.Context, req CandleRangeRequest) ([]Candle, error) {
+	var candles []Candle
+	for candle, err := range c.CandleRange(ctx, req) {
+		if err != nil {
+			return candles, err
+		}
+		candles = append(candles, candle)
+	}
+	return candles, nil
+}
+
+// waitRemaining 그룹의 1초 내 잔여 요청이 없으면 다음 1초가 시작될 때까지 기다린다.
+func (c *Client) waitRemaining(ctx context.Context, group ratelimit.Group) error {
+	remaining, ok := c.RemainingReq(group)
+	if !ok || remaining.Sec > 0 {
+		return nil
+	}
+	wait := time.Until(remaining.UpdatedAt.Truncate(time.Second).Add(time.Second))
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,276 @@
+package public
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultCatalogTTL MarketCatalog 의 기본 캐시 유지 시간
+const DefaultCatalogTTL = 10 * time.Minute
+
+type CatalogEventType string
+
+const (
+	MarketListed         CatalogEventType = "listed"          // 신규 상장
+	MarketDelisted       CatalogEventType = "delisted"        // 상장 폐지 (목록에서 사라짐)
+	MarketWarningChanged CatalogEventType = "warning_changed" // 유의 종목 지정/해제
+	MarketCautionChanged CatalogEventType = "caution_changed" // 주의 종목 경보 변경
+)
+
+// CatalogEvent 마켓 목록 변경 이벤트
+type CatalogEvent struct {
+	Type     CatalogEventType
+	Market   Market // 변경 후 정보, 상장 폐지는 마지막으로 알려진 정보
+	Previous Market // 변경 전 정보, 신규 상장은 빈 값
+}
+
+// MarketCatalog GetMarkets 결과를 TTL 동안 캐시하고 코드, 기준/호가 화폐, 이름으로 찾을 수 있게 한다.
+// 갱신할 때마다 이전 목록과 비교해 상장, 상장 폐지, 유의/주의 종목 변경 이벤트를 보낸다.
+// 첫 조회는 비교 대상이 없으므로 이벤트를 보내지 않는다.
+type MarketCatalog struct {
+	client *Client
+	ttl    time.Duration
+
+	mu        sync.RWMutex
+	markets   map[string]Market
+	byBase    map[string][]string
+	byQuote   map[string][]string
+	byName    map[string]string
+	fetchedAt time.Time
+
+	refreshMu sync.Mutex
+	handlers  []func(CatalogEvent)
+}
+
+// NewMarketCatalog ttl 이 0 이하이면 DefaultCatalogTTL 을 사용한다.
+func NewMarketCatalog(client *Client, ttl time.Duration) *MarketCatalog {
+	if ttl <= 0 {
+		ttl = DefaultCatalogTTL
+	}
+	return &MarketCatalog{
+		client:  client,
+		ttl:     ttl,
+		markets: make(map[string]Market),
+	}
+}
+
+// OnEvent 마켓 변경 이벤트 핸들러를 등록한다. 핸들러는 Refresh 를 호출한 고루틴에서 순서대로 호출된다.
+// 핸들러는 갱신 잠금을 해제한 뒤 호출되므로 핸들러 안에서 Refresh, OnEvent 를 호출할 수 있다.
+func (m *MarketCatalog) OnEvent(handler func(CatalogEvent)) {
+	m.refreshMu.Lock()
+	defer m.refreshMu.Unlock()
+	m.handlers = append(m.handlers, handler)
+}
+
+// Refresh 캐시와 관계없이 마켓 목록을 다시 받아 변경 이벤트를 보내고 반환한다.
+func (m *MarketCatalog) Refresh(ctx context.Context) ([]CatalogEvent, error) {
+	m.refreshMu.Lock()
+	events, handlers, err := m.refresh(ctx)
+	m.refreshMu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	dispatch(events, handlers)
+	return events, nil
+}
+
+// refresh refreshMu 를 잡은 상태에서 호출하며, 이벤트와 함께 보낼 핸들러 목록의 복사본을 반환한다.
+func (m *MarketCatalog) refresh(ctx context.Context) ([]CatalogEvent, []func(CatalogEvent), error) {
+	markets, err := m.client.GetMarkets(ctx, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	next := make(map[string]Market, len(markets))
+	for _, market := range markets {
+		next[market.Market] = market
+	}
+
+	m.mu.Lock()
+	var events []CatalogEvent
+	if !m.fetchedAt.IsZero() {
+		events = diffMarkets(m.markets, next)
+	}
+	m.index(next)
+	m.fetchedAt = time.Now()
+	m.mu.Unlock()
+
+	return events, slices.Clone(m.handlers), nil
+}
+
+func dispatch(events []CatalogEvent, handlers []func(CatalogEvent)) {
+	for _, event := range events {
+		for _, handler := range handlers {
+			handler(event)
+		}
+	}
+}
+
+// Run interval 마다 Refresh 를 호출한다. ctx 가 끝나면 반환한다.
+// 갱신 실패는 다음 주기에 다시 시도하며, onError 가 nil 이 아니면 전달한다.
+func (m *MarketCatalog) Run(ctx context.Context, interval time.Duration, onError func(error)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if _, err := m.Refresh(ctx); err != nil && onError != nil && ctx.Err() == nil {
+			onError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *MarketCatalog) ensureFresh(ctx context.Context) error {
+	m.mu.RLock()
+	fresh := !m.fetchedAt.IsZero() && time.Since(m.fetchedAt) < m.ttl
+	m.mu.RUnlock()
+	if fresh {
+		return nil
+	}
+
+	m.refreshMu.Lock()
+	// 기다리는 동안 다른 고루틴이 갱신했을 수 있다.
+	m.mu.RLock()
+	fresh = !m.fetchedAt.IsZero() && time.Since(m.fetchedAt) < m.ttl
+	m.mu.RUnlock()
+	if fresh {
+		m.refreshMu.Unlock()
+		return nil
+	}
+	events, handlers, err := m.refresh(ctx)
+	m.refreshMu.Unlock()
+	if err != nil {
+		return err
+	}
+	dispatch(events, handlers)
+	return nil
+}
+
+func (m *MarketCatalog) index(markets map[string]Market) {
+	m.markets = markets
+	m.byBase = make(map[string][]string)
+	m.byQuote = make(map[string][]string)
+	m.byName = make(map[string]string)
+	for code, market := range markets {
+		if c := MarketCode(code); c.Validate() == nil {
+			m.byQuote[string(c.Quote())] = append(m.byQuote[string(c.Quote())], code)
+			m.byBase[c.Base()] = append(m.byBase[c.Base()], code)
+		}
+		m.byName[normalizeName(market.KoreanName)] = code
+		m.byName[normalizeName(market.EnglishName)] = code
+	}
+	for _, codes := range m.byBase {
+		slices.Sort(codes)
+	}
+	for _, codes := range m.byQuote {
+		slices.Sort(codes)
+	}
+}
+
+func normalizeName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+func (m *MarketCatalog) lookup(codes []string) []Market {
+	markets := make([]Market, 0, len(codes))
+	for _, code := range codes {
+		markets = append(markets, m.markets[code])
+	}
+	return markets
+}
+
+// Markets 모든 마켓을 코드 순으로 반환한다.
+func (m *MarketCatalog) Markets(ctx context.Context) ([]Market, error) {
+	if err := m.ensureFresh(ctx); err != nil {
+		return nil, err
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	codes := make([]string, 0, len(m.markets))
+	for code := range m.markets {
+		codes = append(codes, code)
+	}
+	slices.Sort(codes)
+	return m.lookup(codes), nil
+}
+
+// Get 마켓 코드 (ex. KRW-BTC) 로 찾는다.
+func (m *MarketCatalog) Get(ctx context.Context, code MarketCode) (Market, bool, error) {
+	if err := m.ensureFresh(ctx); err != nil {
+		return Market{}, false, err
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	market, ok := m.markets[string(code)]
+	return market, ok, nil
+}
+
+// ByBaseCurrency 거래 대상 화폐 (ex. BTC) 로 거래되는 마켓을 찾는다.
+func (m *MarketCatalog) ByBaseCurrency(ctx context.Context, base string) ([]Market, error) {
+	if err := m.ensureFresh(ctx); err != nil {
+		return nil, err
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.lookup(m.byBase[strings.ToUpper(base)]), nil
+}
+
+// ByQuoteCurrency 기준 화폐 (ex. KRW) 마켓을 찾는다.
+func (m *MarketCatalog) ByQuoteCurrency(ctx context.Context, quote QuoteCurrency) ([]Market, error) {
+	if err := m.ensureFresh(ctx); err != nil {
+		return nil, err
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.lookup(m.byQuote[strings.ToUpper(string(quote))]), nil
+}
+
+// ByName 한글 또는 영문 이름 (대소문자 무시) 으로 찾는다.
+// 같은 이름이 여러 마켓에 있으면 그 중 하나를 반환하므로 ByBaseCurrency 로 다시 찾는다.
+func (m *MarketCatalog) ByName(ctx context.Context, name string) ([]Market, error) {
+	if err := m.ensureFresh(ctx); err != nil {
+		return nil, err
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	code, ok := m.byName[normalizeName(name)]
+	if !ok {
+		return nil, nil
+	}
+	return m.lookup(m.byBase[MarketCode(code).Base()]), nil
+}
+
+// diffMarkets prev 와 next 를 비교해 코드 순으로 이벤트를 만든다.
+func diffMarkets(prev, next map[string]Market) []CatalogEvent {
+	var events []CatalogEvent
+	for code, market := range next {
+		old, ok := prev[code]
+		if !ok {
+			events = append(events, CatalogEvent{Type: MarketListed, Market: market})
+			continue
+		}
+		if old.MarketWarning != market.MarketWarning || old.MarketEvent.Warning != market.MarketEvent.Warning {
+			events = append(events, CatalogEvent{Type: MarketWarningChanged, Market: market, Previous: old})
+		}
+		if old.MarketEvent.Caution != market.MarketEvent.Caution {
+			events = append(events, CatalogEvent{Type: MarketCautionChanged, Market: market, Previous: old})
+		}
+	}
+	for code, old := range prev {
+		if _, ok := next[code]; !ok {
+			events = append(events, CatalogEvent{Type: MarketDelisted, Market: old, Previous: old})
+		}
+	}
+	slices.SortStableFunc(events, func(a, b CatalogEvent) int {
+		return strings.Compare(a.Market.Market, b.Market.Market)
+	})
+	return events
+}
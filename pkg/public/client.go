@@ -1,89 +1,75 @@
 package public
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
-	"time"
+
+	"github.com/wooobo/go-upbit-client/internal/transport"
+	"github.com/wooobo/go-upbit-client/pkg/apierror"
+	"github.com/wooobo/go-upbit-client/pkg/ratelimit"
+	"github.com/wooobo/go-upbit-client/pkg/retry"
+)
+
+// APIError 업비트 API 에러 응답. errors.As 또는 apierror.As 로 꺼낼 수 있다.
+type APIError = apierror.Error
+
+// Option NewClient 에 전달하는 클라이언트 옵션
+type Option = transport.Option
+
+// Interceptor 모든 요청 전후에 실행되는 훅 (BeforeSend, AfterReceive, OnError)
+type Interceptor = transport.Interceptor
+
+// Call 인터셉터에 전달되는 요청 경로, 쿼리, 상태 코드, 소요 시간, 에러 정보
+type Call = transport.Call
+
+var (
+	WithHTTPClient   = transport.WithHTTPClient   // 요청에 사용할 *http.Client
+	WithBaseURL      = transport.WithBaseURL      // 버전을 포함한 API 기본 URL (Config.BaseUrl, Config.Version 보다 우선)
+	WithUserAgent    = transport.WithUserAgent    // User-Agent 헤더
+	WithTimeout      = transport.WithTimeout      // 요청 타임아웃 (default: 10s)
+	WithRoundTripper = transport.WithRoundTripper // http.RoundTripper 미들웨어
+	WithInterceptors = transport.WithInterceptors // 요청 전후 인터셉터
+	WithLogger       = transport.WithLogger       // log/slog 요청 로그 (인증 정보는 기록하지 않음)
 )
 
 type Config struct {
 	BaseUrl string
 	Version string
+	Limiter *ratelimit.Limiter // 클라이언트 측 요청 수 제한, nil 이면 제한하지 않음
+	Retry   *retry.Policy      // GET 요청 재시도 정책, nil 이면 retry.DefaultPolicy
 }
 
 type Client struct {
-	baseURL    string
-	httpClient *http.Client
+	transport *transport.Transport
 }
 
-func NewClient(client Config) *Client {
+func NewClient(client Config, opts ...Option) *Client {
+	base := []Option{transport.WithLimiter(client.Limiter)}
+	if client.Retry != nil {
+		base = append(base, transport.WithRetry(*client.Retry))
+	}
+
 	return &Client{
-		baseURL: fmt.Sprintf("%s%s", client.BaseUrl, client.Version),
-		httpClient: &http.Client{
-			Timeout: time.Second * 10,
-		},
+		transport: transport.New(fmt.Sprintf("%s%s", client.BaseUrl, client.Version), append(base, opts...)...),
 	}
 }
 
 func (c *Client) Get(ctx context.Context, path string, params url.Values, v interface{}) error {
-	return c.do(ctx, http.MethodGet, path, params, nil, v)
+	return c.transport.Do(ctx, transport.Request{
+		Method: http.MethodGet,
+		Path:   path,
+		Query:  params,
+	}, v)
 }
 
-func (c *Client) do(ctx context.Context, method, path string, params url.Values, body interface{}, v interface{}) error {
-	u, err := url.Parse(c.baseURL + path)
-	if err != nil {
-		return fmt.Errorf("parsing URL: %w", err)
-	}
-
-	if params != nil {
-		u.RawQuery = params.Encode()
-	}
-
-	var bodyReader io.Reader
-	if body != nil {
-		jsonBody, err := json.Marshal(body)
-		if err != nil {
-			return fmt.Errorf("marshaling request body: %w", err)
-		}
-		bodyReader = bytes.NewReader(jsonBody)
-	}
-
-	req, err := http.NewRequestWithContext(ctx, method, u.String(), bodyReader)
-	if err != nil {
-		return fmt.Errorf("creating request: %w", err)
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("sending request: %w", err)
-	}
-	defer func() {
-		if err := resp.Body.Close(); err != nil {
-			err = fmt.Errorf("closing response body: %w", err)
-		}
-	}()
-
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode, string(body))
-	}
-
-	if v != nil {
-		if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
-			return fmt.Errorf("decoding response body: %w", err)
-		}
-	}
-
-	return nil
+// RemainingReq 그룹의 가장 최근 잔여 요청 수 (Remaining-Req 헤더)
+func (c *Client) RemainingReq(group ratelimit.Group) (ratelimit.Remaining, bool) {
+	return c.transport.RemainingReq(group)
 }
 
-func (c *Client) toUrl(u string) string {
-	return fmt.Sprintf("%s%s", c.baseURL, u)
+// RemainingReqs 모든 그룹의 가장 최근 잔여 요청 수
+func (c *Client) RemainingReqs() map[ratelimit.Group]ratelimit.Remaining {
+	return c.transport.RemainingReqs()
 }
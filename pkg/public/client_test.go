@@ -0,0 +1,412 @@
+package public
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wooobo/go-upbit-client/pkg/apierror"
+	"github.com/wooobo/go-upbit-client/pkg/market"
+	"github.com/wooobo/go-upbit-client/pkg/retry"
+)
+
+func testServerClient(t *testing.T, handler http.HandlerFunc) *Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return NewClient(Config{
+		BaseUrl: server.URL,
+		Version: testVer,
+		Retry:   &retry.NoRetry,
+	})
+}
+
+func TestClient_GetCandles_Time(t *testing.T) {
+	client := testServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/candles/days", r.URL.Path)
+		assert.Equal(t, "2024-09-19T00:00:00Z", r.URL.Query().Get("to"))
+		_, _ = w.Write([]byte(`[{"market":"KRW-BTC","candle_date_time_utc":"2024-09-18T00:00:00","candle_date_time_kst":"2024-09-18T09:00:00","trade_price":80000000.0}]`))
+	})
+
+	actual, err := client.GetCandles(context.Background(), CandleRequest{
+		Market:         "KRW-BTC",
+		CandleInterval: Day,
+		Count:          1,
+		To:             time.Date(2024, 9, 19, 9, 0, 0, 0, KST),
+	})
+
+	assert.NoError(t, err)
+	if assert.Len(t, actual, 1) {
+		want := time.Date(2024, 9, 18, 0, 0, 0, 0, time.UTC)
+		assert.True(t, want.Equal(actual[0].CandleDateTimeUTC))
+		assert.True(t, want.Equal(actual[0].CandleDateTimeKST))
+		assert.Equal(t, KST, actual[0].CandleDateTimeKST.Location())
+	}
+}
+
+func TestClient_GetTradeTicks_Time(t *testing.T) {
+	client := testServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "07:12:33", r.URL.Query().Get("to"))
+		_, _ = w.Write([]byte(`[{"market":"KRW-BTC","trade_date_utc":"2024-09-19","trade_time_utc":"07:12:30","sequential_id":1}]`))
+	})
+
+	actual, err := client.GetTradeTicks(context.Background(), TradeTicksRequest{
+		Market: "KRW-BTC",
+		Count:  1,
+		To:     time.Date(2024, 9, 19, 16, 12, 33, 0, KST),
+	})
+
+	assert.NoError(t, err)
+	if assert.Len(t, actual, 1) {
+		assert.Equal(t, time.Date(2024, 9, 19, 7, 12, 30, 0, time.UTC), actual[0].TradeDateTimeUTC)
+	}
+}
+
+func TestTickerSnapshot_UnmarshalJSON(t *testing.T) {
+	client := testServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"market":"KRW-BTC","trade_date":"20240919","trade_time":"071233","trade_date_kst":"20240919","trade_time_kst":"161233"}]`))
+	})
+
+	actual, err := client.GetTickerPrice(context.Background(), []MarketCode{"KRW-BTC"})
+
+	assert.NoError(t, err)
+	if assert.Len(t, actual, 1) {
+		assert.Equal(t, time.Date(2024, 9, 19, 7, 12, 33, 0, time.UTC), actual[0].TradeDateTimeUTC)
+		assert.True(t, actual[0].TradeDateTimeUTC.Equal(actual[0].TradeDateTimeKST))
+	}
+}
+
+func TestClient_CandleRange(t *testing.T) {
+	oldest := time.Date(2024, 9, 19, 0, 0, 0, 0, time.UTC)
+	latest := oldest.Add(449 * time.Minute)
+	var calls int
+
+	client := testServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		assert.Equal(t, "/v1/candles/minutes/1", r.URL.Path)
+
+		at := latest
+		if to := r.URL.Query().Get("to"); to != "" {
+			parsed, err := time.Parse(candleToLayout, to)
+			assert.NoError(t, err)
+			// 경계 캔들을 한 번 더 내려주는 서버에서도 중복 없이 동작해야 한다.
+			at = parsed
+		}
+
+		var body []byte
+		body = append(body, '[')
+		for i := 0; i < maxCandleCount && !at.Before(oldest); i++ {
+			if i > 0 {
+				body = append(body, ',')
+			}
+			body = append(body, `{"market":"KRW-BTC","candle_date_time_utc":"`+at.Format(candleTimeLayout)+`"}`...)
+			at = at.Add(-time.Minute)
+		}
+		body = append(body, ']')
+		_, _ = w.Write(body)
+	})
+
+	actual, err := client.GetCandleRange(context.Background(), CandleRangeRequest{
+		Market:         "KRW-BTC",
+		CandleInterval: Minute,
+		UnitCount:      1,
+		From:           oldest.Add(10 * time.Minute),
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, actual, 440)
+	assert.Equal(t, latest, actual[0].CandleDateTimeUTC)
+	assert.Equal(t, oldest.Add(10*time.Minute), actual[len(actual)-1].CandleDateTimeUTC)
+	for i := 1; i < len(actual); i++ {
+		assert.True(t, actual[i].CandleDateTimeUTC.Before(actual[i-1].CandleDateTimeUTC))
+	}
+	assert.Equal(t, 3, calls)
+}
+
+func TestClient_GetCandles_Interval(t *testing.T) {
+	var paths []string
+	client := testServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		_, _ = w.Write([]byte(`[]`))
+	})
+
+	tests := []struct {
+		name    string
+		req     CandleRequest
+		wantErr error
+	}{
+		{name: "Second", req: CandleRequest{Market: "KRW-BTC", CandleInterval: Second, Count: 1}},
+		{name: "Year", req: CandleRequest{Market: "KRW-BTC", CandleInterval: Year, Count: 1}},
+		{name: "Minute 240", req: CandleRequest{Market: "KRW-BTC", CandleInterval: Minute, UnitCount: 240, Count: 1}},
+		{name: "Minute 2", req: CandleRequest{Market: "KRW-BTC", CandleInterval: Minute, UnitCount: 2, Count: 1}, wantErr: ErrInvalidCandleUnit},
+		{name: "Minute without unit", req: CandleRequest{Market: "KRW-BTC", CandleInterval: Minute, Count: 1}, wantErr: ErrInvalidCandleUnit},
+		{name: "Unknown", req: CandleRequest{Market: "KRW-BTC", CandleInterval: "hour", Count: 1}, wantErr: ErrInvalidCandleInterval},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.GetCandles(context.Background(), tt.req)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+	assert.Equal(t, []string{"/v1/candles/seconds", "/v1/candles/years", "/v1/candles/minutes/240"}, paths)
+}
+
+func TestClient_GetCandles_ConvertingPriceUnit(t *testing.T) {
+	client := testServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "KRW", r.URL.Query().Get("convertingPriceUnit"))
+		_, _ = w.Write([]byte(`[{"market":"BTC-ETH","candle_date_time_utc":"2024-09-18T00:00:00","trade_price":0.042,"prev_closing_price":0.041,"change_price":0.001,"change_rate":0.0243902439,"converted_trade_price":3360000.0}]`))
+	})
+
+	actual, err := client.GetCandles(context.Background(), CandleRequest{
+		Market:              "BTC-ETH",
+		CandleInterval:      Day,
+		Count:               1,
+		ConvertingPriceUnit: KRW,
+	})
+
+	assert.NoError(t, err)
+	if assert.Len(t, actual, 1) {
+		assert.Equal(t, "3360000", actual[0].ConvertedTradePrice.String())
+		assert.Equal(t, "0.041", actual[0].PrevClosingPrice.String())
+		assert.Equal(t, "0.001", actual[0].ChangePrice.String())
+		assert.InDelta(t, 0.0243902439, actual[0].ChangeRate, 1e-12)
+	}
+
+	_, err = client.GetCandles(context.Background(), CandleRequest{
+		Market:              "BTC-ETH",
+		CandleInterval:      Week,
+		Count:               1,
+		ConvertingPriceUnit: KRW,
+	})
+	assert.ErrorIs(t, err, ErrConvertingPriceUnit)
+}
+
+func TestClient_TradeTickRange(t *testing.T) {
+	base := time.Date(2024, 9, 19, 0, 0, 0, 0, time.UTC)
+	days := map[string][2]int64{
+		"1": {1, 700},    // 하루 전
+		"":  {690, 1200}, // 오늘, 하루 전과 일부 겹침
+	}
+
+	client := testServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		assert.Equal(t, "500", query.Get("count"))
+		seqRange := days[query.Get("daysAgo")]
+		upper := seqRange[1]
+		if cursor := query.Get("cursor"); cursor != "" {
+			n, err := strconv.ParseInt(cursor, 10, 64)
+			assert.NoError(t, err)
+			upper = n - 1
+		}
+
+		var ticks []string
+		for seq := upper; seq >= seqRange[0] && len(ticks) < 500; seq-- {
+			ticks = append(ticks, fmt.Sprintf(`{"market":"KRW-BTC","timestamp":%d,"sequential_id":%d}`,
+				base.Add(time.Duration(seq)*time.Second).UnixMilli(), seq))
+		}
+		_, _ = w.Write([]byte("[" + strings.Join(ticks, ",") + "]"))
+	})
+
+	actual, err := client.GetTradeTickRange(context.Background(), TradeTickRangeRequest{
+		Market:  "KRW-BTC",
+		DaysAgo: 1,
+		From:    base.Add(100 * time.Second),
+		Until:   base.Add(1100 * time.Second),
+	})
+
+	assert.NoError(t, err)
+	if assert.Len(t, actual, 1000) {
+		assert.Equal(t, int64(100), actual[0].SequentialID)
+		assert.Equal(t, int64(1099), actual[len(actual)-1].SequentialID)
+		for i := 1; i < len(actual); i++ {
+			assert.Equal(t, actual[i-1].SequentialID+1, actual[i].SequentialID)
+		}
+	}
+
+	_, err = client.GetTradeTickRange(context.Background(), TradeTickRangeRequest{Market: "KRW-BTC", DaysAgo: 8})
+	assert.ErrorIs(t, err, ErrInvalidDaysAgo)
+}
+
+func TestClient_GetTickerPrice_Batch(t *testing.T) {
+	var mu sync.Mutex
+	var maxMarkets int
+	client := testServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		markets := strings.Split(r.URL.Query().Get("markets"), ",")
+		mu.Lock()
+		maxMarkets = max(maxMarkets, len(markets))
+		mu.Unlock()
+
+		var tickers []string
+		for _, market := range markets {
+			if market == "KRW-INVALID" {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"error":{"name":404,"message":"Code not found"}}`))
+				return
+			}
+			tickers = append(tickers, `{"market":"`+market+`"}`)
+		}
+		_, _ = w.Write([]byte("[" + strings.Join(tickers, ",") + "]"))
+	})
+
+	markets := make([]MarketCode, 0, 250)
+	for i := 0; i < 249; i++ {
+		markets = append(markets, MarketCode(fmt.Sprintf("KRW-C%03d", i)))
+	}
+	markets = append(markets[:120], append([]MarketCode{"KRW-INVALID"}, markets[120:]...)...)
+
+	actual, err := client.GetTickerPrice(context.Background(), markets)
+
+	var batchErr *BatchError
+	if assert.ErrorAs(t, err, &batchErr) {
+		assert.Len(t, batchErr.Failures, 1)
+		assert.ErrorIs(t, batchErr.Failures["KRW-INVALID"], apierror.ErrNotFound)
+		assert.ErrorIs(t, err, apierror.ErrNotFound)
+	}
+	assert.Len(t, actual, 249)
+	assert.Equal(t, "KRW-C000", actual[0].Market)
+	assert.Equal(t, "KRW-C248", actual[248].Market)
+	assert.LessOrEqual(t, maxMarkets, marketBatchSize)
+}
+
+func TestBatchError_Error(t *testing.T) {
+	assert.Equal(t, "batch request failed", (&BatchError{}).Error())
+
+	err := &BatchError{Failures: map[string]error{"KRW-B": apierror.ErrNotFound, "KRW-A": apierror.ErrBadRequest}}
+	assert.True(t, strings.HasPrefix(err.Error(), "2 markets failed: KRW-A,KRW-B: "))
+}
+
+func TestMarketCatalog(t *testing.T) {
+	responses := []string{
+		`[{"market":"KRW-BTC","korean_name":"비트코인","english_name":"Bitcoin","market_warning":"NONE"},
+		  {"market":"BTC-ETH","korean_name":"이더리움","english_name":"Ethereum","market_warning":"NONE"},
+		  {"market":"KRW-ETH","korean_name":"이더리움","english_name":"Ethereum","market_warning":"NONE"},
+		  {"market":"KRW-XRP","korean_name":"리플","english_name":"Ripple","market_warning":"NONE"}]`,
+		`[{"market":"KRW-BTC","korean_name":"비트코인","english_name":"Bitcoin","market_warning":"NONE","market_event":{"caution":{"price_fluctuations":true}}},
+		  {"market":"BTC-ETH","korean_name":"이더리움","english_name":"Ethereum","market_warning":"NONE"},
+		  {"market":"KRW-ETH","korean_name":"이더리움","english_name":"Ethereum","market_warning":"CAUTION","market_event":{"warning":true}},
+		  {"market":"KRW-SOL","korean_name":"솔라나","english_name":"Solana","market_warning":"NONE"}]`,
+	}
+	calls := 0
+	client := testServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/market/all", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("isDetails"))
+		_, _ = w.Write([]byte(responses[min(calls, len(responses)-1)]))
+		calls++
+	})
+	ctx := context.Background()
+	catalog := NewMarketCatalog(client, time.Hour)
+
+	var received []CatalogEvent
+	catalog.OnEvent(func(event CatalogEvent) { received = append(received, event) })
+
+	markets, err := catalog.Markets(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, markets, 4)
+	assert.Equal(t, "BTC-ETH", markets[0].Market)
+
+	market, ok, err := catalog.Get(ctx, "KRW-BTC")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "Bitcoin", market.EnglishName)
+
+	byBase, err := catalog.ByBaseCurrency(ctx, "eth")
+	assert.NoError(t, err)
+	assert.Len(t, byBase, 2)
+
+	byQuote, err := catalog.ByQuoteCurrency(ctx, KRW)
+	assert.NoError(t, err)
+	assert.Len(t, byQuote, 3)
+
+	byName, err := catalog.ByName(ctx, "리플")
+	assert.NoError(t, err)
+	assert.Len(t, byName, 1)
+	assert.Equal(t, "KRW-XRP", byName[0].Market)
+
+	assert.Equal(t, 1, calls, "cached within ttl")
+	assert.Empty(t, received, "no events on first load")
+
+	events, err := catalog.Refresh(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, events, received)
+
+	var types []string
+	for _, event := range events {
+		types = append(types, event.Market.Market+":"+string(event.Type))
+	}
+	assert.Equal(t, []string{
+		"KRW-BTC:" + string(MarketCautionChanged),
+		"KRW-ETH:" + string(MarketWarningChanged),
+		"KRW-SOL:" + string(MarketListed),
+		"KRW-XRP:" + string(MarketDelisted),
+	}, types)
+	assert.Equal(t, "NONE", events[1].Previous.MarketWarning)
+	assert.Equal(t, "CAUTION", events[1].Market.MarketWarning)
+}
+
+func TestMarketCatalog_HandlerReentry(t *testing.T) {
+	responses := []string{
+		`[{"market":"KRW-BTC","market_warning":"NONE"}]`,
+		`[{"market":"KRW-BTC","market_warning":"NONE"},{"market":"KRW-SOL","market_warning":"NONE"}]`,
+	}
+	var calls atomic.Int32
+	client := testServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		n := int(calls.Add(1)) - 1
+		_, _ = w.Write([]byte(responses[min(n, len(responses)-1)]))
+	})
+	ctx := context.Background()
+	catalog := NewMarketCatalog(client, time.Hour)
+
+	var nested int
+	catalog.OnEvent(func(event CatalogEvent) {
+		catalog.OnEvent(func(CatalogEvent) { nested++ })
+		_, err := catalog.Refresh(ctx)
+		assert.NoError(t, err)
+	})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, err := catalog.Refresh(ctx)
+		assert.NoError(t, err)
+		_, err = catalog.Refresh(ctx)
+		assert.NoError(t, err)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("handler calling Refresh or OnEvent deadlocked")
+	}
+	assert.Equal(t, int32(3), calls.Load())
+	assert.Equal(t, 0, nested, "handlers registered during dispatch see later events only")
+}
+
+func TestClient_InvalidMarketCode(t *testing.T) {
+	client := testServerClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s", r.URL.Path)
+	})
+	ctx := context.Background()
+
+	_, err := client.GetTickerPrice(ctx, []MarketCode{"KRW-BTC, BTC-ETH"})
+	assert.ErrorIs(t, err, market.ErrInvalidCode)
+
+	_, err = client.GetCandles(ctx, CandleRequest{Market: "EUR-BTC", CandleInterval: Day, Count: 1})
+	assert.ErrorIs(t, err, market.ErrInvalidCode)
+
+	code, err := market.ParseCode("krw-btc")
+	assert.NoError(t, err)
+	assert.Equal(t, KRW, code.Quote())
+	assert.Equal(t, "BTC", code.Base())
+}
@@ -1,27 +1,53 @@
 package public
 
+import "time"
+
 type CandleInterval string
 
 const (
+	Second CandleInterval = "second"
 	Minute CandleInterval = "minute"
 	Day    CandleInterval = "day"
 	Week   CandleInterval = "week"
 	Month  CandleInterval = "month"
+	Year   CandleInterval = "year"
 )
 
+// MinuteUnits 분 캔들에서 지원하는 분 단위
+var MinuteUnits = []int{1, 3, 5, 10, 15, 30, 60, 240}
+
 type CandleRequest struct {
-	Market string `json:"market"`       // 마켓 코드 (ex. KRW-BTC)
-	To     string `json:"to,omitempty"` // 마지막 캔들 시각 (ISO8061 포맷)
-	Count  int    `json:"count"`        // 요청할 캔들 개수 (최대 200개)
+	Market MarketCode `json:"market"`       // 마켓 코드 (ex. KRW-BTC)
+	To     time.Time  `json:"to,omitempty"` // 마지막 캔들 시각 (exclusive), 비어 있으면 가장 최근 캔들
+	Count  int        `json:"count"`        // 요청할 캔들 개수 (최대 200개)
 	CandleInterval
-	UnitCount int `json:"unit,omitempty"` // 분 단위 (유닛), optional
+	UnitCount           int           `json:"unit,omitempty"`                // 분 단위 (유닛), optional
+	ConvertingPriceUnit QuoteCurrency `json:"convertingPriceUnit,omitempty"` // 종가 환산 화폐 단위 (ex. KRW), 일(Day) 캔들에서만 사용 가능
 }
 
 // TradeTicksRequest 최근 체결 내역
 type TradeTicksRequest struct {
-	Market  string `json:"market"`
-	To      string `json:"to,omitempty"`
-	Count   int    `json:"count,omitempty"`
-	Cursor  string `json:"cursor,omitempty"`
-	DaysAgo int    `json:"daysAgo,omitempty"`
+	Market  MarketCode `json:"market"`
+	To      time.Time  `json:"to,omitempty"` // 마지막 체결 시각, 날짜는 무시하고 UTC 기준 시:분:초만 사용 (날짜는 DaysAgo 로 지정)
+	Count   int        `json:"count,omitempty"`
+	Cursor  string     `json:"cursor,omitempty"`
+	DaysAgo int        `json:"daysAgo,omitempty"`
+}
+
+// CandleRangeRequest 기간 캔들 조회
+type CandleRangeRequest struct {
+	Market MarketCode // 마켓 코드 (ex. KRW-BTC)
+	CandleInterval
+	UnitCount           int           // 분 단위 (유닛), optional
+	ConvertingPriceUnit QuoteCurrency // 종가 환산 화폐 단위, 일(Day) 캔들에서만 사용 가능
+	From                time.Time     // 조회 시작 시각 (inclusive), 비어 있으면 가장 오래된 캔들까지
+	To                  time.Time     // 조회 종료 시각 (exclusive), 비어 있으면 가장 최근 캔들부터
+}
+
+// TradeTickRangeRequest 여러 날에 걸친 체결 내역 조회
+type TradeTickRangeRequest struct {
+	Market  MarketCode // 마켓 코드 (ex. KRW-BTC)
+	DaysAgo int        // 며칠 전부터 조회할지 (0 ~ 7, 0 이면 오늘 체결만)
+	From    time.Time  // 조회 시작 시각 (inclusive), optional
+	Until   time.Time  // 조회 종료 시각 (exclusive), optional, 이 시각 이후의 체결을 만나면 조회를 끝낸다.
 }
@@ -0,0 +1,36 @@
+package public
+
+import (
+	"fmt"
+	"time"
+)
+
+// KST 한국 표준시 (UTC+9)
+var KST = time.FixedZone("KST", 9*60*60)
+
+// ToKST t 를 한국 표준시로 변환한다.
+func ToKST(t time.Time) time.Time {
+	return t.In(KST)
+}
+
+// ToUTC t 를 UTC 로 변환한다.
+func ToUTC(t time.Time) time.Time {
+	return t.UTC()
+}
+
+// parseTime 시간대가 없는 업비트 시각 문자열을 loc 기준으로 해석한다.
+// 다시 인코딩된 RFC3339 문자열도 받는다.
+func parseTime(value string, loc *time.Location, layouts ...string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	for _, layout := range layouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+	if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return t.In(loc), nil
+	}
+	return time.Time{}, fmt.Errorf("parsing time %q", value)
+}
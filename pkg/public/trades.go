@@ -0,0 +1,125 @@
+package public
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/wooobo/go-upbit-client/pkg/ratelimit"
+)
+
+const (
+	maxTradeTickCount = 500 // 체결 조회 한 번에 받을 수 있는 최대 개수
+	maxTradeDaysAgo   = 7   // 체결 조회가 가능한 최대 일수
+)
+
+var ErrInvalidDaysAgo = errors.New("invalid daysAgo")
+
+// TradeTickRange DaysAgo 일 전부터 오늘까지의 체결을 시간 순(오래된 체결부터)으로 반환한다.
+// 하루 단위로 cursor 를 따라 모든 페이지를 받은 뒤 sequential_id 로 중복을 제거하고 정렬해서 내보낸다.
+// Until 이후의 체결을 만나면 끝나며, 에러가 발생하면 에러를 한 번 반환하고 끝난다.
+func (c *Client) TradeTickRange(ctx context.Context, req TradeTickRangeRequest) iter.Seq2[TradeTick, error] {
+	return func(yield func(TradeTick, error) bool) {
+		if req.DaysAgo < 0 || req.DaysAgo > maxTradeDaysAgo {
+			yield(TradeTick{}, fmt.Errorf("%w: %d, must be between 0 and %d", ErrInvalidDaysAgo, req.DaysAgo, maxTradeDaysAgo))
+			return
+		}
+
+		var last TradeTick
+		for daysAgo := req.DaysAgo; daysAgo >= 0; daysAgo-- {
+			ticks, err := c.tradeTicksOfDay(ctx, req.Market, daysAgo, req.From)
+			if err != nil {
+				yield(TradeTick{}, err)
+				return
+			}
+
+			for _, tick := range ticks {
+				// 날짜 경계에서 이전 날과 겹친 체결은 건너뛴다.
+				if last.SequentialID != 0 && compareTradeTick(tick, last) <= 0 {
+					continue
+				}
+				at := time.UnixMilli(tick.Timestamp)
+				if !req.From.IsZero() && at.Before(req.From) {
+					continue
+				}
+				if !req.Until.IsZero() && !at.Before(req.Until) {
+					return
+				}
+				last = tick
+				if !yield(tick, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// GetTradeTickRange TradeTickRange 의 결과를 모두 모아 시간 순으로 반환한다.
+func (c *Client) GetTradeTickRange(ctx context.Context, req TradeTickRangeRequest) ([]TradeTick, error) {
+	var ticks []TradeTick
+	for tick, err := range c.TradeTickRange(ctx, req) {
+		if err != nil {
+			return ticks, err
+		}
+		ticks = append(ticks, tick)
+	}
+	return ticks, nil
+}
+
+// tradeTicksOfDay daysAgo 일 전의 체결을 cursor 로 끝까지 받아 시간 순으로 정렬한다.
+// from 보다 오래된 페이지에 도달하면 더 받지 않는다.
+func (c *Client) tradeTicksOfDay(ctx context.Context, market MarketCode, daysAgo int, from time.Time) ([]TradeTick, error) {
+	seen := make(map[int64]struct{})
+	var ticks []TradeTick
+	cursor := ""
+
+	for {
+		page, err := c.GetTradeTicks(ctx, TradeTicksRequest{
+			Market:  market,
+			Count:   maxTradeTickCount,
+			Cursor:  cursor,
+			DaysAgo: daysAgo,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tick := range page {
+			if _, ok := seen[tick.SequentialID]; ok {
+				continue
+			}
+			seen[tick.SequentialID] = struct{}{}
+			ticks = append(ticks, tick)
+		}
+		if len(page) < maxTradeTickCount {
+			break
+		}
+
+		oldest := page[len(page)-1]
+		next := strconv.FormatInt(oldest.SequentialID, 10)
+		if next == cursor {
+			break
+		}
+		if !from.IsZero() && time.UnixMilli(oldest.Timestamp).Before(from) {
+			break
+		}
+		cursor = next
+
+		if err := c.waitRemaining(ctx, ratelimit.GroupTrades); err != nil {
+			return nil, err
+		}
+	}
+
+	slices.SortFunc(ticks, compareTradeTick)
+	return ticks, nil
+}
+
+// compareTradeTick 체결 시각, sequential_id 순으로 비교한다.
+func compareTradeTick(a, b TradeTick) int {
+	return cmp.Or(cmp.Compare(a.Timestamp, b.Timestamp), cmp.Compare(a.SequentialID, b.SequentialID))
+}
@@ -0,0 +1,256 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// HeaderRemainingReq 업비트가 모든 REST 응답에 포함하는 잔여 요청 수 헤더
+const HeaderRemainingReq = "Remaining-Req"
+
+// Group 업비트 요청 수 제한 그룹
+type Group string
+
+func (g Group) String() string { return string(g) }
+
+const (
+	GroupMarket    Group = "market"    // 종목 코드 조회
+	GroupCandles   Group = "candles"   // 캔들 조회
+	GroupTrades    Group = "trades"    // 체결 조회
+	GroupTicker    Group = "ticker"    // 현재가 조회
+	GroupOrderbook Group = "orderbook" // 호가 조회
+	GroupOrder     Group = "order"     // 주문 생성/취소
+	GroupDefault   Group = "default"   // 그 외 Exchange API
+)
+
+// DefaultRates 그룹별 초당 최대 요청 수 (업비트 문서 기준)
+var DefaultRates = map[Group]int{
+	GroupMarket:    10,
+	GroupCandles:   10,
+	GroupTrades:    10,
+	GroupTicker:    10,
+	GroupOrderbook: 10,
+	GroupOrder:     8,
+	GroupDefault:   30,
+}
+
+// GroupOf 요청 메서드와 경로(버전 제외, ex. /candles/days)로 요청 수 제한 그룹을 결정한다.
+func GroupOf(method, path string) Group {
+	switch {
+	case strings.HasPrefix(path, "/market"):
+		return GroupMarket
+	case strings.HasPrefix(path, "/candles"):
+		return GroupCandles
+	case strings.HasPrefix(path, "/trades"):
+		return GroupTrades
+	case strings.HasPrefix(path, "/ticker"):
+		return GroupTicker
+	case strings.HasPrefix(path, "/orderbook"):
+		return GroupOrderbook
+	case method != http.MethodGet && strings.HasPrefix(path, "/order"):
+		return GroupOrder
+	default:
+		return GroupDefault
+	}
+}
+
+// Remaining Remaining-Req 헤더로 전달된 그룹별 잔여 요청 수
+type Remaining struct {
+	Group     Group     // 요청 수 제한 그룹
+	Min       int       // 1분 내 잔여 요청 수 (헤더에 없으면 -1)
+	Sec       int       // 1초 내 잔여 요청 수
+	UpdatedAt time.Time // 헤더를 받은 시각
+}
+
+// ParseRemainingReq "group=order; min=1800; sec=7" 형식의 헤더 값을 해석한다.
+func ParseRemainingReq(value string) (Remaining, error) {
+	r := Remaining{Min: -1, Sec: -1}
+	for _, part := range strings.Split(value, ";") {
+		key, val, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch strings.TrimSpace(key) {
+		case "group":
+			r.Group = Group(strings.TrimSpace(val))
+		case "min":
+			n, err := strconv.Atoi(strings.TrimSpace(val))
+			if err != nil {
+				return Remaining{}, fmt.Errorf("parsing min of %q: %w", value, err)
+			}
+			r.Min = n
+		case "sec":
+			n, err := strconv.Atoi(strings.TrimSpace(val))
+			if err != nil {
+				return Remaining{}, fmt.Errorf("parsing sec of %q: %w", value, err)
+			}
+			r.Sec = n
+		}
+	}
+	if r.Group == "" || r.Sec < 0 {
+		return Remaining{}, fmt.Errorf("invalid %s header: %q", HeaderRemainingReq, value)
+	}
+	return r, nil
+}
+
+// Tracker 그룹별로 가장 최근에 받은 잔여 요청 수를 보관한다.
+type Tracker struct {
+	mu        sync.RWMutex
+	remaining map[Group]Remaining
+}
+
+func NewTracker() *Tracker {
+	return &Tracker{remaining: make(map[Group]Remaining)}
+}
+
+// Update 응답 헤더에서 Remaining-Req 를 읽어 저장한다. 헤더가 없거나 잘못된 경우 false 를 반환한다.
+func (t *Tracker) Update(header http.Header) (Remaining, bool) {
+	value := header.Get(HeaderRemainingReq)
+	if value == "" {
+		return Remaining{}, false
+	}
+	r, err := ParseRemainingReq(value)
+	if err != nil {
+		return Remaining{}, false
+	}
+	r.UpdatedAt = time.Now()
+
+	t.mu.Lock()
+	t.remaining[r.Group] = r
+	t.mu.Unlock()
+	return r, true
+}
+
+// Get 그룹의 최근 잔여 요청 수
+func (t *Tracker) Get(group Group) (Remaining, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	r, ok := t.remaining[group]
+	return r, ok
+}
+
+// All 모든 그룹의 최근 잔여 요청 수
+func (t *Tracker) All() map[Group]Remaining {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	all := make(map[Group]Remaining, len(t.remaining))
+	for g, r := range t.remaining {
+		all[g] = r
+	}
+	return all
+}
+
+// Limiter 그룹별 토큰 버킷으로 요청을 조절하는 클라이언트 측 제한기
+// 여러 고루틴, 여러 클라이언트가 하나의 Limiter 를 공유해도 안전하다.
+type Limiter struct {
+	mu      sync.Mutex
+	rates   map[Group]int
+	buckets map[Group]*bucket
+	now     func() time.Time
+}
+
+type bucket struct {
+	tokens       float64
+	rate         float64
+	last         time.Time
+	blockedUntil time.Time
+}
+
+// NewLimiter 그룹별 초당 요청 수로 Limiter 를 만든다. rates 가 nil 이면 DefaultRates 를 사용하며,
+// 정의되지 않은 그룹은 GroupDefault 의 요청 수를 따른다.
+func NewLimiter(rates map[Group]int) *Limiter {
+	if rates == nil {
+		rates = DefaultRates
+	}
+	copied := make(map[Group]int, len(rates))
+	for g, r := range rates {
+		copied[g] = r
+	}
+	return &Limiter{
+		rates:   copied,
+		buckets: make(map[Group]*bucket),
+		now:     time.Now,
+	}
+}
+
+func (l *Limiter) bucket(group Group) *bucket {
+	b, ok := l.buckets[group]
+	if ok {
+		return b
+	}
+	rate, ok := l.rates[group]
+	if !ok {
+		rate = l.rates[GroupDefault]
+	}
+	if rate <= 0 {
+		rate = DefaultRates[GroupDefault]
+	}
+	b = &bucket{tokens: float64(rate), rate: float64(rate), last: l.now()}
+	l.buckets[group] = b
+	return b
+}
+
+// Wait 그룹의 요청 한 건을 보낼 수 있을 때까지 기다린다.
+func (l *Limiter) Wait(ctx context.Context, group Group) error {
+	for {
+		l.mu.Lock()
+		now := l.now()
+		b := l.bucket(group)
+		b.refill(now)
+
+		var wait time.Duration
+		switch {
+		case now.Before(b.blockedUntil):
+			wait = b.blockedUntil.Sub(now)
+		case b.tokens >= 1:
+			b.tokens--
+			l.mu.Unlock()
+			return nil
+		default:
+			wait = time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+		}
+		l.mu.Unlock()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Observe 서버가 알려준 잔여 요청 수를 반영한다.
+// 1초 내 잔여 요청이 없으면 다음 1초가 시작될 때까지 해당 그룹의 요청을 막는다.
+func (l *Limiter) Observe(r Remaining) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	b := l.bucket(r.Group)
+	b.refill(now)
+	if float64(r.Sec) < b.tokens {
+		b.tokens = float64(r.Sec)
+	}
+	if r.Sec == 0 {
+		b.blockedUntil = now.Truncate(time.Second).Add(time.Second)
+	}
+}
+
+func (b *bucket) refill(now time.Time) {
+	elapsed := now.Sub(b.last).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+	b.tokens += elapsed * b.rate
+	if b.tokens > b.rate {
+		b.tokens = b.rate
+	}
+	b.last = now
+}
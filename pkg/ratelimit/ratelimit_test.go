@@ -0,0 +1,96 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRemainingReq(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    Remaining
+		wantErr bool
+	}{
+		{
+			name:  "group, min, sec",
+			value: "group=order; min=1800; sec=7",
+			want:  Remaining{Group: GroupOrder, Min: 1800, Sec: 7},
+		},
+		{
+			name:  "without min",
+			value: "group=candles; sec=9",
+			want:  Remaining{Group: GroupCandles, Min: -1, Sec: 9},
+		},
+		{
+			name:    "missing group",
+			value:   "min=1800; sec=7",
+			wantErr: true,
+		},
+		{
+			name:    "invalid sec",
+			value:   "group=default; sec=x",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := ParseRemainingReq(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, actual)
+		})
+	}
+}
+
+func TestGroupOf(t *testing.T) {
+	assert.Equal(t, GroupMarket, GroupOf(http.MethodGet, "/market/all"))
+	assert.Equal(t, GroupCandles, GroupOf(http.MethodGet, "/candles/minutes/1"))
+	assert.Equal(t, GroupTicker, GroupOf(http.MethodGet, "/ticker/all"))
+	assert.Equal(t, GroupOrderbook, GroupOf(http.MethodGet, "/orderbook"))
+	assert.Equal(t, GroupOrder, GroupOf(http.MethodPost, "/orders"))
+	assert.Equal(t, GroupOrder, GroupOf(http.MethodDelete, "/order"))
+	assert.Equal(t, GroupDefault, GroupOf(http.MethodGet, "/orders/open"))
+	assert.Equal(t, GroupDefault, GroupOf(http.MethodGet, "/accounts"))
+}
+
+func TestTracker_Update(t *testing.T) {
+	tracker := NewTracker()
+
+	_, ok := tracker.Update(http.Header{})
+	assert.False(t, ok)
+
+	header := http.Header{}
+	header.Set(HeaderRemainingReq, "group=default; min=1799; sec=29")
+	_, ok = tracker.Update(header)
+	assert.True(t, ok)
+
+	actual, ok := tracker.Get(GroupDefault)
+	assert.True(t, ok)
+	assert.Equal(t, 29, actual.Sec)
+	assert.Len(t, tracker.All(), 1)
+}
+
+func TestLimiter_Wait(t *testing.T) {
+	limiter := NewLimiter(map[Group]int{GroupOrder: 2})
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, limiter.Wait(ctx, GroupOrder))
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+
+	timeout, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	limiter.Observe(Remaining{Group: GroupOrder, Sec: 0})
+	assert.ErrorIs(t, limiter.Wait(timeout, GroupOrder), context.DeadlineExceeded)
+}
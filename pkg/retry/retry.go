@@ -0,0 +1,135 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/wooobo/go-upbit-client/pkg/apierror"
+)
+
+// Policy 요청 재시도 정책
+type Policy struct {
+	MaxAttempts int           // 최초 요청을 포함한 최대 시도 횟수, 1 이하이면 재시도하지 않음
+	BaseDelay   time.Duration // 첫 재시도 전 대기 시간, 이후 시도마다 두 배씩 늘어남
+	MaxDelay    time.Duration // 최대 대기 시간 (Retry-After 헤더 포함)
+}
+
+var (
+	// DefaultPolicy Config 에 재시도 정책을 지정하지 않았을 때 사용하는 정책
+	DefaultPolicy = Policy{MaxAttempts: 3, BaseDelay: 200 * time.Millisecond, MaxDelay: 5 * time.Second}
+	// NoRetry 재시도하지 않는 정책
+	NoRetry = Policy{MaxAttempts: 1}
+)
+
+// Backoff attempt 번째 시도가 실패한 뒤 기다릴 시간
+// 지수 백오프에 지터를 더하며, retryAfter 가 있으면 그 이상 기다린다.
+func (p Policy) Backoff(attempt int, retryAfter time.Duration) time.Duration {
+	delay := p.BaseDelay
+	for i := 1; i < attempt && (p.MaxDelay <= 0 || delay < p.MaxDelay); i++ {
+		delay *= 2
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if delay > 0 {
+		delay = delay/2 + rand.N(delay/2+1)
+	}
+	if retryAfter > delay {
+		delay = retryAfter
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	return delay
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent err 를 재시도하지 않도록 표시한다.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// Retryable err 가 재시도할 만한 에러인지 확인한다.
+// 429, 5xx 응답과 네트워크 에러(net.Error, 응답이 중간에 끊긴 경우)만 재시도한다.
+// 그 외 API 에러, 컨텍스트 취소, 요청 생성이나 응답 디코딩 실패 같은 에러는 재시도하지 않는다.
+func Retryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	var permanent *permanentError
+	if errors.As(err, &permanent) {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if apiErr, ok := apierror.As(err); ok {
+		return apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode >= 500
+	}
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// RetryAfter API 에러의 Retry-After 헤더 값 (초 또는 HTTP 날짜)
+func RetryAfter(err error) time.Duration {
+	apiErr, ok := apierror.As(err)
+	if !ok || apiErr.Header == nil {
+		return 0
+	}
+	value := apiErr.Header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return time.Until(at)
+	}
+	return 0
+}
+
+// Do 정책에 따라 fn 을 반복 호출한다. attempt 는 1부터 시작한다.
+// fn 이 재시도할 수 없는 에러를 반환하거나 시도 횟수를 모두 쓰면 마지막 에러를 반환한다.
+// 재시도를 기다리는 동안 ctx 가 끝나면 ctx.Err() 와 마지막 에러를 함께 반환한다.
+func Do(ctx context.Context, p Policy, fn func(attempt int) error) error {
+	for attempt := 1; ; attempt++ {
+		err := fn(attempt)
+		if err == nil {
+			return nil
+		}
+		if attempt >= p.MaxAttempts || !Retryable(err) {
+			var permanent *permanentError
+			if errors.As(err, &permanent) && permanent == err {
+				return permanent.err
+			}
+			return err
+		}
+
+		timer := time.NewTimer(p.Backoff(attempt, RetryAfter(err)))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.Join(ctx.Err(), err)
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wooobo/go-upbit-client/pkg/apierror"
+)
+
+func TestPolicy_Backoff(t *testing.T) {
+	p := Policy{MaxAttempts: 5, BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+
+	for attempt := 1; attempt <= 5; attempt++ {
+		delay := p.Backoff(attempt, 0)
+		assert.LessOrEqual(t, delay, time.Second)
+		assert.Greater(t, delay, time.Duration(0))
+	}
+	assert.GreaterOrEqual(t, p.Backoff(3, 0), 200*time.Millisecond)
+	assert.Equal(t, time.Second, p.Backoff(1, 3*time.Second))
+}
+
+func TestRetryable(t *testing.T) {
+	assert.True(t, Retryable(&apierror.Error{StatusCode: http.StatusTooManyRequests}))
+	assert.True(t, Retryable(&apierror.Error{StatusCode: http.StatusBadGateway}))
+	assert.True(t, Retryable(fmt.Errorf("sending request: %w", &net.OpError{Op: "read", Err: errors.New("connection reset")})))
+	assert.True(t, Retryable(fmt.Errorf("sending request: %w", io.ErrUnexpectedEOF)))
+	assert.False(t, Retryable(errors.New("decoding response body: invalid character")))
+	assert.False(t, Retryable(&apierror.Error{StatusCode: http.StatusBadRequest, Name: "insufficient_funds_bid"}))
+	assert.False(t, Retryable(fmt.Errorf("sending request: %w", context.Canceled)))
+	assert.False(t, Retryable(Permanent(errors.New("stop"))))
+}
+
+func TestRetryAfter(t *testing.T) {
+	err := &apierror.Error{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": []string{"2"}}}
+	assert.Equal(t, 2*time.Second, RetryAfter(err))
+	assert.Equal(t, time.Duration(0), RetryAfter(errors.New("x")))
+}
+
+func TestDo(t *testing.T) {
+	p := Policy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond}
+	ctx := context.Background()
+
+	calls := 0
+	err := Do(ctx, p, func(attempt int) error {
+		calls++
+		if attempt < 3 {
+			return &apierror.Error{StatusCode: http.StatusServiceUnavailable}
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+
+	calls = 0
+	err = Do(ctx, p, func(int) error {
+		calls++
+		return apierror.ErrBadRequest
+	})
+	assert.ErrorIs(t, err, apierror.ErrBadRequest)
+	assert.Equal(t, 1, calls)
+
+	stop := errors.New("stop")
+	err = Do(ctx, p, func(int) error { return Permanent(stop) })
+	assert.Equal(t, stop, err)
+
+	cancelCtx, cancel := context.WithCancel(ctx)
+	calls = 0
+	err = Do(cancelCtx, Policy{MaxAttempts: 3, BaseDelay: time.Hour, MaxDelay: time.Hour}, func(int) error {
+		calls++
+		cancel()
+		return apierror.ErrTooManyRequests
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.ErrorIs(t, err, apierror.ErrTooManyRequests)
+	assert.Equal(t, 1, calls)
+}
@@ -7,6 +7,9 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
+	"io"
+	"log/slog"
+	"strings"
 	"sync"
 	"time"
 )
@@ -18,20 +21,68 @@ const (
 	pingPeriod          = (pongWait * 9) / 10
 )
 
+type options struct {
+	logger *slog.Logger
+}
+
+// Option 웹소켓 연결 옵션
+type Option func(*options)
+
+// WithLogger 연결, 구독, 종료, PING 실패를 log/slog 로 기록한다.
+// JWT 와 access key, secret key 는 기록하지 않는다.
+func WithLogger(logger *slog.Logger) Option {
+	return func(o *options) {
+		if logger != nil {
+			o.logger = logger
+		}
+	}
+}
+
+func newOptions(opts []Option) options {
+	o := options{logger: slog.New(slog.NewTextHandler(io.Discard, nil))}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
 type PublicWebSocket struct {
-	conn *websocket.Conn
-	mu   sync.Mutex
+	conn   *websocket.Conn
+	mu     sync.Mutex
+	logger *slog.Logger
 }
 
-func NewPublicWebSocket() (*PublicWebSocket, error) {
+func NewPublicWebSocket(opts ...Option) (*PublicWebSocket, error) {
+	o := newOptions(opts)
 	conn, _, err := websocket.DefaultDialer.Dial(publicWebsocketURL, nil)
 	if err != nil {
+		o.logger.Warn("upbit websocket connect failed", slog.String("url", publicWebsocketURL), slog.Any("error", err))
 		return nil, err
 	}
-	return &PublicWebSocket{conn: conn}, nil
+	o.logger.Info("upbit websocket connected", slog.String("url", publicWebsocketURL))
+	return &PublicWebSocket{conn: conn, logger: o.logger}, nil
+}
+
+// validateCodes 구독할 마켓 코드를 검증한다.
+// 호가는 "KRW-BTC.15" 처럼 호가 단위 개수를 붙일 수 있으므로 "." 앞부분만 검증한다.
+func validateCodes(typeField TypeField) error {
+	for _, code := range typeField.Codes {
+		if typeField.Type == TypeOrderbook {
+			if i := strings.IndexByte(string(code), '.'); i >= 0 {
+				code = code[:i]
+			}
+		}
+		if err := code.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (p *PublicWebSocket) Subscribe(typeField TypeField, format string) error {
+	if err := validateCodes(typeField); err != nil {
+		return err
+	}
 	request := p.parseParams(typeField, format)
 	message, err := json.Marshal(request)
 	if err != nil {
@@ -42,8 +93,10 @@ func (p *PublicWebSocket) Subscribe(typeField TypeField, format string) error {
 	defer p.mu.Unlock()
 	err = p.conn.WriteMessage(websocket.TextMessage, message)
 	if err != nil {
+		p.logger.Warn("upbit websocket subscribe failed", subscriptionAttrs(typeField), slog.Any("error", err))
 		return err
 	}
+	p.logger.Info("upbit websocket subscribed", subscriptionAttrs(typeField))
 	return nil
 }
 
@@ -84,6 +137,7 @@ func (p *PublicWebSocket) ReadMessage(v interface{}) error {
 func (p *PublicWebSocket) Close() error {
 	p.mu.Lock()
 	defer p.mu.Unlock()
+	p.logger.Info("upbit websocket closed", slog.String("url", publicWebsocketURL))
 	return p.conn.Close()
 }
 
@@ -93,9 +147,11 @@ type PrivateWebSocket struct {
 	accessKey string
 	secretKey string
 	closeCh   chan struct{}
+	logger    *slog.Logger
 }
 
-func NewPrivateWebSocket(accessKey, secretKey string) (*PrivateWebSocket, error) {
+func NewPrivateWebSocket(accessKey, secretKey string, opts ...Option) (*PrivateWebSocket, error) {
+	o := newOptions(opts)
 	token, err := generateJWT(accessKey, secretKey)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate JWT: %v", err)
@@ -104,27 +160,28 @@ func NewPrivateWebSocket(accessKey, secretKey string) (*PrivateWebSocket, error)
 	headers["Authorization"] = []string{"Bearer " + token}
 	conn, _, err := websocket.DefaultDialer.Dial(privateWebsocketURL, headers)
 	if err != nil {
+		o.logger.Warn("upbit websocket connect failed", slog.String("url", privateWebsocketURL), slog.Any("error", err))
 		return nil, err
 	}
+	o.logger.Info("upbit websocket connected", slog.String("url", privateWebsocketURL))
 
 	closeCh := make(chan struct{})
-	go func() {
-		err := ping(conn, closeCh)
-		if err != nil {
-			fmt.Println("Error sending PING frame:", err)
-		} else {
-			fmt.Println("PING frame sent")
-		}
-	}()
+	go ping(conn, closeCh, o.logger)
 
 	return &PrivateWebSocket{
 		conn:      conn,
 		accessKey: accessKey,
 		secretKey: secretKey,
 		closeCh:   closeCh,
+		logger:    o.logger,
 	}, nil
 }
 
+// LogValue 로그에 access key, secret key 가 남지 않도록 가린다.
+func (p *PrivateWebSocket) LogValue() slog.Value {
+	return slog.GroupValue(slog.String("url", privateWebsocketURL))
+}
+
 func generateJWT(accessKey, secretKey string) (string, error) {
 	claims := jwt.MapClaims{
 		"access_key": accessKey,
@@ -135,6 +192,9 @@ func generateJWT(accessKey, secretKey string) (string, error) {
 }
 
 func (p *PrivateWebSocket) Subscribe(typeField TypeField, format string) error {
+	if err := validateCodes(typeField); err != nil {
+		return err
+	}
 	request := []Request{
 		{
 			Ticket: typeField.Ticket,
@@ -165,8 +225,10 @@ func (p *PrivateWebSocket) Subscribe(typeField TypeField, format string) error {
 	defer p.mu.Unlock()
 	err = p.conn.WriteMessage(websocket.TextMessage, message)
 	if err != nil {
+		p.logger.Warn("upbit websocket subscribe failed", subscriptionAttrs(typeField), slog.Any("error", err))
 		return err
 	}
+	p.logger.Info("upbit websocket subscribed", subscriptionAttrs(typeField))
 
 	return nil
 }
@@ -187,10 +249,11 @@ func (p *PrivateWebSocket) Close() error {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 	close(p.closeCh)
+	p.logger.Info("upbit websocket closed", slog.String("url", privateWebsocketURL))
 	return p.conn.Close()
 }
 
-func ping(conn *websocket.Conn, closeCh chan struct{}) error {
+func ping(conn *websocket.Conn, closeCh chan struct{}, logger *slog.Logger) {
 	pingTicker := time.NewTicker(pingPeriod)
 	defer pingTicker.Stop()
 	for {
@@ -198,9 +261,18 @@ func ping(conn *websocket.Conn, closeCh chan struct{}) error {
 		case <-pingTicker.C:
 			err := conn.WriteMessage(websocket.PingMessage, []byte("PING"))
 			if err != nil {
-				fmt.Println("Error sending PING frame:", err)
+				logger.Warn("upbit websocket ping failed", slog.String("url", privateWebsocketURL), slog.Any("error", err))
 			}
 		case <-closeCh:
+			return
 		}
 	}
 }
+
+func subscriptionAttrs(typeField TypeField) slog.Attr {
+	return slog.Group("subscription",
+		slog.String("ticket", typeField.Ticket),
+		slog.String("type", string(typeField.Type)),
+		slog.Any("codes", typeField.Codes),
+	)
+}
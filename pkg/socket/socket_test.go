@@ -2,7 +2,13 @@ package socket
 
 import (
 	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/wooobo/go-upbit-client/pkg/market"
 	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -21,7 +27,7 @@ func TestWebsocket_Public(t *testing.T) {
 	typeField := TypeField{
 		Ticket: uuid.New().String(),
 		Type:   TypeTicker,
-		Codes:  []string{"KRW-BTC", "KRW-ETH"},
+		Codes:  []market.Code{"KRW-BTC", "KRW-ETH"},
 	}
 	err = ws.Subscribe(typeField, "DEFAULT")
 	if err != nil {
@@ -36,7 +42,7 @@ func TestWebsocket_Public(t *testing.T) {
 			return
 		}
 
-		log.Printf("Ticker: %s, TradePrice: %f\n", got.Code, got.TradePrice)
+		log.Printf("Ticker: %s, TradePrice: %s\n", got.Code, got.TradePrice)
 	}
 }
 
@@ -58,7 +64,7 @@ func TestWebSocket_Private(t *testing.T) {
 	types := TypeField{
 		Ticket: uuid.New().String(),
 		Type:   TypeMyOrder,
-		Codes:  []string{},
+		Codes:  []market.Code{},
 	}
 
 	err = ws.Subscribe(types, "DEFAULT")
@@ -111,3 +117,35 @@ func TestWebSocket_Private_Asset(t *testing.T) {
 		log.Printf("MyOrder: %s, UUID: %s, State: %s\n", got.Code, got.UUID, got.State)
 	}
 }
+
+func TestPublicWebSocket_Subscribe_OrderbookUnit(t *testing.T) {
+	received := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, message, err := conn.ReadMessage()
+		if err == nil {
+			received <- message
+		}
+	}))
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	ws := &PublicWebSocket{conn: conn, logger: newOptions(nil).logger}
+	defer ws.Close()
+
+	err = ws.Subscribe(TypeField{Ticket: "test", Type: TypeOrderbook, Codes: []market.Code{"KRW-BTC.5"}}, "")
+	assert.NoError(t, err)
+	assert.Contains(t, string(<-received), `"codes":["KRW-BTC.5"]`)
+
+	err = ws.Subscribe(TypeField{Ticket: "test", Type: TypeTicker, Codes: []market.Code{"KRW-BTC.5"}}, "")
+	assert.ErrorIs(t, err, market.ErrInvalidCode)
+	err = ws.Subscribe(TypeField{Ticket: "test", Type: TypeOrderbook, Codes: []market.Code{"krw-btc.5"}}, "")
+	assert.ErrorIs(t, err, market.ErrInvalidCode)
+}
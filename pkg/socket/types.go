@@ -1,5 +1,10 @@
 package socket
 
+import (
+	"github.com/wooobo/go-upbit-client/pkg/decimal"
+	"github.com/wooobo/go-upbit-client/pkg/market"
+)
+
 type SubscriptionType string
 
 const (
@@ -13,7 +18,7 @@ const (
 type Request struct {
 	Ticket         string           `json:"ticket,omitempty"`
 	Type           SubscriptionType `json:"type,omitempty"`
-	Codes          []string         `json:"codes"`
+	Codes          []market.Code    `json:"codes"`
 	IsOnlySnapshot bool             `json:"isOnlySnapshot,omitempty"`
 	IsOnlyRealtime bool             `json:"isOnlyRealtime,omitempty"`
 	Format         string           `json:"format,omitempty"`
@@ -25,7 +30,7 @@ type TicketConfig struct {
 type TypeField struct {
 	Ticket         string           `json:"ticket,omitempty"`
 	Type           SubscriptionType `json:"type"`
-	Codes          []string         `json:"codes,omitempty"`
+	Codes          []market.Code    `json:"codes,omitempty"` // 마켓 코드 (ex. KRW-BTC), 구독 전에 검증한다.
 	IsOnlySnapshot bool             `json:"isOnlySnapshot,omitempty"`
 	IsOnlyRealtime bool             `json:"isOnlyRealtime,omitempty"`
 	Level          *float64         `json:"level,omitempty"`
@@ -35,74 +40,78 @@ type FormatField struct {
 	Format string `json:"format,omitempty"`
 }
 type TickerResponse struct {
-	Type              string  `json:"type"`
-	Code              string  `json:"code"`
-	OpeningPrice      float64 `json:"opening_price"`
-	HighPrice         float64 `json:"high_price"`
-	LowPrice          float64 `json:"low_price"`
-	TradePrice        float64 `json:"trade_price"`
-	PrevClosingPrice  float64 `json:"prev_closing_price"`
-	Change            string  `json:"change"`
-	ChangePrice       float64 `json:"change_price"`
-	SignedChangePrice float64 `json:"signed_change_price"`
-	ChangeRate        float64 `json:"change_rate"`
-	SignedChangeRate  float64 `json:"signed_change_rate"`
-	TradeVolume       float64 `json:"trade_volume"`
-	AccTradeVolume    float64 `json:"acc_trade_volume"`
-	AccTradePrice     float64 `json:"acc_trade_price"`
-	TradeDate         string  `json:"trade_date"`
-	TradeTime         string  `json:"trade_time"`
-	TradeTimestamp    int64   `json:"trade_timestamp"`
-	StreamType        string  `json:"stream_type"`
+	Type              string          `json:"type"`
+	Code              string          `json:"code"`
+	OpeningPrice      decimal.Decimal `json:"opening_price"`
+	HighPrice         decimal.Decimal `json:"high_price"`
+	LowPrice          decimal.Decimal `json:"low_price"`
+	TradePrice        decimal.Decimal `json:"trade_price"`
+	PrevClosingPrice  decimal.Decimal `json:"prev_closing_price"`
+	Change            string          `json:"change"`
+	ChangePrice       decimal.Decimal `json:"change_price"`
+	SignedChangePrice decimal.Decimal `json:"signed_change_price"`
+	ChangeRate        float64         `json:"change_rate"`
+	SignedChangeRate  float64         `json:"signed_change_rate"`
+	TradeVolume       decimal.Decimal `json:"trade_volume"`
+	AccTradeVolume    decimal.Decimal `json:"acc_trade_volume"`
+	AccTradePrice     decimal.Decimal `json:"acc_trade_price"`
+	TradeDate         string          `json:"trade_date"`
+	TradeTime         string          `json:"trade_time"`
+	TradeTimestamp    int64           `json:"trade_timestamp"`
+	StreamType        string          `json:"stream_type"`
 }
 
 type TradeResponse struct {
-	Type             string  `json:"type"`
-	Code             string  `json:"code"`
-	TradePrice       float64 `json:"trade_price"`
-	TradeVolume      float64 `json:"trade_volume"`
-	AskBid           string  `json:"ask_bid"`
-	PrevClosingPrice float64 `json:"prev_closing_price"`
-	Change           string  `json:"change"`
-	ChangePrice      float64 `json:"change_price"`
-	TradeDate        string  `json:"trade_date"`
-	TradeTime        string  `json:"trade_time"`
-	TradeTimestamp   int64   `json:"trade_timestamp"`
-	StreamType       string  `json:"stream_type"`
+	Type             string          `json:"type"`
+	Code             string          `json:"code"`
+	TradePrice       decimal.Decimal `json:"trade_price"`
+	TradeVolume      decimal.Decimal `json:"trade_volume"`
+	AskBid           string          `json:"ask_bid"`
+	PrevClosingPrice decimal.Decimal `json:"prev_closing_price"`
+	Change           string          `json:"change"`
+	ChangePrice      decimal.Decimal `json:"change_price"`
+	TradeDate        string          `json:"trade_date"`
+	TradeTime        string          `json:"trade_time"`
+	TradeTimestamp   int64           `json:"trade_timestamp"`
+	StreamType       string          `json:"stream_type"`
 }
 
 type OrderbookResponse struct {
 	Type           string          `json:"type"`
 	Code           string          `json:"code"`
 	Timestamp      int64           `json:"timestamp"`
-	TotalAskSize   float64         `json:"total_ask_size"`
-	TotalBidSize   float64         `json:"total_bid_size"`
+	TotalAskSize   decimal.Decimal `json:"total_ask_size"`
+	TotalBidSize   decimal.Decimal `json:"total_bid_size"`
 	OrderbookUnits []OrderbookUnit `json:"orderbook_units"`
 	StreamType     string          `json:"stream_type"`
 	Level          float64         `json:"level"`
 }
 
 type MyOrderResponse struct {
-	Type            string  `json:"type"`
-	Code            string  `json:"code"`
-	UUID            string  `json:"uuid"`
-	AskBid          string  `json:"ask_bid"`
-	OrderType       string  `json:"order_type"`
-	Price           float64 `json:"price"`
-	AvgPrice        float64 `json:"avg_price"`
-	State           string  `json:"state"`
-	Volume          float64 `json:"volume"`
-	RemainingVolume float64 `json:"remaining_volume"`
-	ExecutedVolume  float64 `json:"executed_volume"`
-	TradesCount     int     `json:"trades_count"`
-	Timestamp       int64   `json:"timestamp"`
-	StreamType      string  `json:"stream_type"`
+	Type            string          `json:"type"`
+	Code            string          `json:"code"`
+	UUID            string          `json:"uuid"`
+	AskBid          string          `json:"ask_bid"`
+	OrderType       string          `json:"order_type"`
+	Price           decimal.Decimal `json:"price"`
+	AvgPrice        decimal.Decimal `json:"avg_price"`
+	State           string          `json:"state"`
+	Volume          decimal.Decimal `json:"volume"`
+	RemainingVolume decimal.Decimal `json:"remaining_volume"`
+	ExecutedVolume  decimal.Decimal `json:"executed_volume"`
+	TradesCount     int             `json:"trades_count"`
+	TimeInForce     string          `json:"time_in_force"`    // IOC, FOK, post_only 설정
+	SMPType         string          `json:"smp_type"`         // 자전거래 체결 방지 모드
+	PreventedVolume decimal.Decimal `json:"prevented_volume"` // 자전거래 방지로 취소된 수량
+	PreventedLocked decimal.Decimal `json:"prevented_locked"` // 자전거래 방지로 해제된 자산
+	Timestamp       int64           `json:"timestamp"`
+	StreamType      string          `json:"stream_type"`
 }
 
 type Asset struct {
-	Currency string  `json:"currency"`
-	Balance  float64 `json:"balance"`
-	Locked   float64 `json:"locked"`
+	Currency string          `json:"currency"`
+	Balance  decimal.Decimal `json:"balance"`
+	Locked   decimal.Decimal `json:"locked"`
 }
 
 type MyAssetResponse struct {
@@ -115,9 +124,9 @@ type MyAssetResponse struct {
 }
 
 type OrderbookUnit struct {
-	AskPrice float64 `json:"ask_price"`       // 매도호가
-	BidPrice float64 `json:"bid_price"`       // 매수호가
-	AskSize  float64 `json:"ask_size"`        // 매도 잔량
-	BidSize  float64 `json:"bid_size"`        // 매수 잔량
-	Level    float64 `json:"level,omitempty"` // 호가 모아보기 단위 (default: 0, 기본 호가단위)
+	AskPrice decimal.Decimal `json:"ask_price"`       // 매도호가
+	BidPrice decimal.Decimal `json:"bid_price"`       // 매수호가
+	AskSize  decimal.Decimal `json:"ask_size"`        // 매도 잔량
+	BidSize  decimal.Decimal `json:"bid_size"`        // 매수 잔량
+	Level    float64         `json:"level,omitempty"` // 호가 모아보기 단위 (default: 0, 기본 호가단위)
 }
@@ -0,0 +1,150 @@
+package ticksize
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/wooobo/go-upbit-client/pkg/decimal"
+	"github.com/wooobo/go-upbit-client/pkg/market"
+)
+
+var (
+	ErrInvalidPrice = errors.New("price must be positive")
+	ErrInvalidTick  = errors.New("price does not match tick size")
+	ErrUnsupported  = errors.New("unsupported quote currency")
+)
+
+// band From 이상 가격의 호가 단위
+type band struct {
+	From decimal.Decimal
+	Tick decimal.Decimal
+}
+
+// krwBands 원화 마켓 호가 단위 (높은 가격대부터)
+var krwBands = []band{
+	{From: decimal.MustParse("2000000"), Tick: decimal.MustParse("1000")},
+	{From: decimal.MustParse("1000000"), Tick: decimal.MustParse("500")},
+	{From: decimal.MustParse("500000"), Tick: decimal.MustParse("100")},
+	{From: decimal.MustParse("100000"), Tick: decimal.MustParse("50")},
+	{From: decimal.MustParse("10000"), Tick: decimal.MustParse("10")},
+	{From: decimal.MustParse("1000"), Tick: decimal.MustParse("1")},
+	{From: decimal.MustParse("100"), Tick: decimal.MustParse("0.1")},
+	{From: decimal.MustParse("10"), Tick: decimal.MustParse("0.01")},
+	{From: decimal.MustParse("1"), Tick: decimal.MustParse("0.001")},
+	{From: decimal.MustParse("0.1"), Tick: decimal.MustParse("0.0001")},
+	{From: decimal.MustParse("0.01"), Tick: decimal.MustParse("0.00001")},
+	{From: decimal.MustParse("0.001"), Tick: decimal.MustParse("0.000001")},
+	{From: decimal.MustParse("0.0001"), Tick: decimal.MustParse("0.0000001")},
+	{From: decimal.Zero, Tick: decimal.MustParse("0.00000001")},
+}
+
+// btcBands BTC 마켓 호가 단위 (가격과 관계없이 고정)
+var btcBands = []band{
+	{From: decimal.Zero, Tick: decimal.MustParse("0.00000001")},
+}
+
+// usdtBands USDT 마켓 호가 단위 (높은 가격대부터)
+var usdtBands = []band{
+	{From: decimal.MustParse("10"), Tick: decimal.MustParse("0.01")},
+	{From: decimal.MustParse("1"), Tick: decimal.MustParse("0.001")},
+	{From: decimal.MustParse("0.1"), Tick: decimal.MustParse("0.0001")},
+	{From: decimal.MustParse("0.01"), Tick: decimal.MustParse("0.00001")},
+	{From: decimal.MustParse("0.001"), Tick: decimal.MustParse("0.000001")},
+	{From: decimal.MustParse("0.0001"), Tick: decimal.MustParse("0.0000001")},
+	{From: decimal.Zero, Tick: decimal.MustParse("0.00000001")},
+}
+
+func bandsOf(quote market.QuoteCurrency) ([]band, error) {
+	switch quote {
+	case market.KRW:
+		return krwBands, nil
+	case market.BTC:
+		return btcBands, nil
+	case market.USDT:
+		return usdtBands, nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupported, quote)
+	}
+}
+
+// Tick 기준 화폐 마켓에서 price 가격대의 호가 단위
+func Tick(quote market.QuoteCurrency, price decimal.Decimal) (decimal.Decimal, error) {
+	if !price.IsPositive() {
+		return decimal.Zero, fmt.Errorf("%w: %s", ErrInvalidPrice, price)
+	}
+	bands, err := bandsOf(quote)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	for _, b := range bands {
+		if price.GreaterThanOrEqual(b.From) {
+			return b.Tick, nil
+		}
+	}
+	return bands[len(bands)-1].Tick, nil
+}
+
+// Mode 호가 단위에 맞출 때의 반올림 방향
+type Mode int
+
+const (
+	Nearest Mode = iota // 가장 가까운 호가 (중간값은 올림)
+	Down                // 내림 (매수 주문에 유리)
+	Up                  // 올림 (매도 주문에 유리)
+)
+
+// Round 마켓의 호가 단위에 맞게 price 를 맞춘다.
+// 가격대 경계는 위아래 호가 단위의 배수이므로 결과는 항상 유효한 호가이다.
+func Round(code market.Code, price decimal.Decimal, mode Mode) (decimal.Decimal, error) {
+	tick, err := Tick(code.Quote(), price)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	var rounded decimal.Decimal
+	switch mode {
+	case Down:
+		rounded = price.FloorStep(tick)
+	case Up:
+		rounded = price.CeilStep(tick)
+	default:
+		rounded = price.RoundStep(tick)
+	}
+	if !rounded.IsPositive() {
+		// 가장 낮은 호가보다 작은 가격을 내리면 0 이 되므로 유효한 호가가 없다.
+		if mode == Down {
+			return decimal.Zero, fmt.Errorf("%w: %s is below the lowest tick %s", ErrInvalidPrice, price, tick)
+		}
+		rounded = tick
+	}
+	return rounded, nil
+}
+
+// RoundDown 호가 단위에 맞게 내린다. 가장 낮은 호가보다 작으면 ErrInvalidPrice 를 반환한다.
+func RoundDown(code market.Code, price decimal.Decimal) (decimal.Decimal, error) {
+	return Round(code, price, Down)
+}
+
+// RoundUp 호가 단위에 맞게 올린다.
+func RoundUp(code market.Code, price decimal.Decimal) (decimal.Decimal, error) {
+	return Round(code, price, Up)
+}
+
+// RoundNearest 가장 가까운 호가로 맞춘다.
+func RoundNearest(code market.Code, price decimal.Decimal) (decimal.Decimal, error) {
+	return Round(code, price, Nearest)
+}
+
+// Validate price 가 마켓의 호가 단위에 맞는지 확인한다.
+// 맞지 않으면 ErrInvalidTick 과 함께 위아래의 유효한 호가를 알려준다.
+func Validate(code market.Code, price decimal.Decimal) error {
+	tick, err := Tick(code.Quote(), price)
+	if err != nil {
+		return err
+	}
+	if price.FloorStep(tick).Equal(price) {
+		return nil
+	}
+	return fmt.Errorf("%w: %s %s (tick %s, nearest valid %s or %s)",
+		ErrInvalidTick, code, price, tick, price.FloorStep(tick), price.CeilStep(tick))
+}
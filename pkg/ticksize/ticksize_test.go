@@ -0,0 +1,91 @@
+package ticksize
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wooobo/go-upbit-client/pkg/decimal"
+	"github.com/wooobo/go-upbit-client/pkg/market"
+)
+
+func TestTick(t *testing.T) {
+	tests := []struct {
+		quote market.QuoteCurrency
+		price string
+		want  string
+	}{
+		{quote: market.KRW, price: "80000000", want: "1000"},
+		{quote: market.KRW, price: "2000000", want: "1000"},
+		{quote: market.KRW, price: "1999999", want: "500"},
+		{quote: market.KRW, price: "650000", want: "100"},
+		{quote: market.KRW, price: "150000", want: "50"},
+		{quote: market.KRW, price: "50000", want: "10"},
+		{quote: market.KRW, price: "5000", want: "1"},
+		{quote: market.KRW, price: "500", want: "0.1"},
+		{quote: market.KRW, price: "50", want: "0.01"},
+		{quote: market.KRW, price: "5", want: "0.001"},
+		{quote: market.KRW, price: "0.5", want: "0.0001"},
+		{quote: market.KRW, price: "0.05", want: "0.00001"},
+		{quote: market.KRW, price: "0.005", want: "0.000001"},
+		{quote: market.KRW, price: "0.0005", want: "0.0000001"},
+		{quote: market.KRW, price: "0.00005", want: "0.00000001"},
+		{quote: market.BTC, price: "0.05", want: "0.00000001"},
+		{quote: market.USDT, price: "65000", want: "0.01"},
+		{quote: market.USDT, price: "2.5", want: "0.001"},
+		{quote: market.USDT, price: "0.5", want: "0.0001"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.quote)+" "+tt.price, func(t *testing.T) {
+			actual, err := Tick(tt.quote, decimal.MustParse(tt.price))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, actual.String())
+		})
+	}
+
+	_, err := Tick(market.KRW, decimal.Zero)
+	assert.ErrorIs(t, err, ErrInvalidPrice)
+	_, err = Tick("EUR", decimal.NewFromInt(1))
+	assert.ErrorIs(t, err, ErrUnsupported)
+}
+
+func TestRound(t *testing.T) {
+	tests := []struct {
+		code  market.Code
+		price string
+		mode  Mode
+		want  string
+	}{
+		{code: "KRW-BTC", price: "80000400", mode: Down, want: "80000000"},
+		{code: "KRW-BTC", price: "80000400", mode: Up, want: "80001000"},
+		{code: "KRW-BTC", price: "80000400", mode: Nearest, want: "80000000"},
+		{code: "KRW-BTC", price: "80000500", mode: Nearest, want: "80001000"},
+		{code: "KRW-BTC", price: "1999999", mode: Up, want: "2000000"},
+		{code: "KRW-XRP", price: "712.36", mode: Down, want: "712.3"},
+		{code: "KRW-XRP", price: "712.36", mode: Up, want: "712.4"},
+		{code: "BTC-ETH", price: "0.051234567", mode: Nearest, want: "0.05123457"},
+		{code: "USDT-BTC", price: "65000.123", mode: Down, want: "65000.12"},
+		{code: "KRW-SHIB", price: "0.000000001", mode: Up, want: "0.00000001"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.code)+" "+tt.price, func(t *testing.T) {
+			actual, err := Round(tt.code, decimal.MustParse(tt.price), tt.mode)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, actual.String())
+			assert.NoError(t, Validate(tt.code, actual))
+		})
+	}
+
+	_, err := Round("KRW-SHIB", decimal.MustParse("0.000000001"), Down)
+	assert.ErrorIs(t, err, ErrInvalidPrice)
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Validate("KRW-BTC", decimal.MustParse("80001000")))
+	assert.NoError(t, Validate("KRW-XRP", decimal.MustParse("712.3")))
+
+	err := Validate("KRW-BTC", decimal.MustParse("80000400"))
+	assert.ErrorIs(t, err, ErrInvalidTick)
+	assert.Contains(t, err.Error(), "80000000 or 80001000")
+}